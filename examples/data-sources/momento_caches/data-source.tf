@@ -1,2 +1,7 @@
 # List all Momento serverless caches.
 data "momento_caches" "all" {}
+
+# List only the caches whose names match a regular expression.
+data "momento_caches" "sessions" {
+  name_regex = "^sessions-[a-z]+$"
+}
@@ -0,0 +1,25 @@
+# The ten highest scoring elements of a leaderboard.
+data "momento_leaderboard" "top_ten" {
+  cache_name = "cache-name"
+  name       = "leaderboard-name"
+  top_n      = 10
+}
+
+# The elements with scores between 1000 and 5000, highest first.
+data "momento_leaderboard" "gold_tier" {
+  cache_name = "cache-name"
+  name       = "leaderboard-name"
+  score_range = {
+    min_score = 1000
+    max_score = 5000
+  }
+  order = "descending"
+}
+
+# The ranks of specific elements.
+data "momento_leaderboard" "players" {
+  cache_name = "cache-name"
+  name       = "leaderboard-name"
+  ids        = [1, 2, 3]
+  order      = "descending"
+}
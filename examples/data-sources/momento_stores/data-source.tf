@@ -0,0 +1,7 @@
+# List all Momento persistent storage stores.
+data "momento_stores" "all" {}
+
+# List only the stores whose names start with "prod-".
+data "momento_stores" "prod" {
+  name_prefix = "prod-"
+}
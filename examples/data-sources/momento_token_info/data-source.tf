@@ -0,0 +1,12 @@
+# Decode the credential the provider is configured with.
+data "momento_token_info" "provider" {}
+
+# Fail the plan if the provider's credential expires within the next week.
+resource "terraform_data" "credential_check" {
+  lifecycle {
+    precondition {
+      condition     = data.momento_token_info.provider.expires_at == null || timecmp(data.momento_token_info.provider.expires_at, timeadd(plantimestamp(), "168h")) > 0
+      error_message = "The Momento credential expires within a week."
+    }
+  }
+}
@@ -0,0 +1,20 @@
+# Plan a multi-AZ cluster for a 120 GiB dataset serving 400,000 operations per second, 90% of them reads.
+data "momento_valkey_capacity_plan" "sessions" {
+  dataset_size_gib    = 120
+  peak_ops_per_second = 400000
+  read_percent        = 90
+  availability        = "multi-az"
+  headroom_percent    = 30
+}
+
+resource "momento_valkey_cluster" "sessions" {
+  cluster_name           = "sessions"
+  node_instance_type     = data.momento_valkey_capacity_plan.sessions.node_instance_type
+  shard_count            = data.momento_valkey_capacity_plan.sessions.shard_count
+  replication_factor     = data.momento_valkey_capacity_plan.sessions.replication_factor
+  enforce_shard_multi_az = data.momento_valkey_capacity_plan.sessions.enforce_shard_multi_az
+}
+
+output "sessions_capacity_rationale" {
+  value = data.momento_valkey_capacity_plan.sessions.rationale
+}
@@ -0,0 +1,15 @@
+# Look up a cluster that is managed in another Terraform state.
+data "momento_valkey_cluster" "shared" {
+  cluster_name = "shared-cluster-name"
+}
+
+resource "momento_object_store" "example" {
+  name                = "object-store-name"
+  s3_bucket_name      = "s3-bucket-name"
+  s3_iam_role_arn     = "s3-iam-role-arn"
+  valkey_cluster_name = data.momento_valkey_cluster.shared.cluster_name
+}
+
+output "shared_cluster_endpoint" {
+  value = "${data.momento_valkey_cluster.shared.configuration_endpoint}:${data.momento_valkey_cluster.shared.port}"
+}
@@ -0,0 +1,8 @@
+# List all Valkey Clusters.
+data "momento_valkey_clusters" "all" {}
+
+# List only the active clusters whose names start with "prod-".
+data "momento_valkey_clusters" "prod" {
+  name_prefix = "prod-"
+  status      = "Active"
+}
@@ -0,0 +1,9 @@
+# List the memory-optimized node types with at least 50 GiB of memory.
+data "momento_valkey_node_types" "large_memory" {
+  family         = "r7g"
+  min_memory_gib = 50
+}
+
+output "smallest_large_memory_node_type" {
+  value = data.momento_valkey_node_types.large_memory.node_types[0].name
+}
@@ -12,3 +12,12 @@ provider "momento" {
   v2_api_key      = "my-momento-api-key"
   v2_api_endpoint = "cell-1-ap-southeast-1-1.prod.a.momentohq.com"
 }
+
+# Tags assigned to every resource that supports tags, in addition to the tags of the resource.
+provider "momento" {
+  default_tags {
+    tags = {
+      team = "platform"
+    }
+  }
+}
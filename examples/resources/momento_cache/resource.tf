@@ -1,4 +1,8 @@
 # Manage a Momento serverless cache.
 resource "momento_cache" "example" {
   name = "cache-name"
+
+  tags = {
+    environment = "production"
+  }
 }
@@ -0,0 +1,2 @@
+# Leaderboards can be imported by specifying the cache name and leaderboard name separated by a slash.
+terraform import momento_leaderboard.example cache-name/leaderboard-name
@@ -0,0 +1,3 @@
+# Leaderboard elements can be imported by specifying the cache name and leaderboard name separated by a slash.
+# Imported leaderboards are managed exclusively, i.e. with ignore_unlisted_elements = false.
+terraform import momento_leaderboard_elements.example cache-name/leaderboard-name
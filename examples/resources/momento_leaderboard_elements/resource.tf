@@ -0,0 +1,20 @@
+resource "momento_cache" "example" {
+  name = "cache-name"
+}
+
+resource "momento_leaderboard" "example" {
+  name       = "leaderboard-name"
+  cache_name = momento_cache.example.name
+}
+
+# Seed the leaderboard with starting scores. Players added by game traffic are left alone.
+resource "momento_leaderboard_elements" "example" {
+  cache_name       = momento_leaderboard.example.cache_name
+  leaderboard_name = momento_leaderboard.example.name
+  elements = {
+    "1" = 100
+    "2" = 250.5
+    "3" = 75
+  }
+  ignore_unlisted_elements = true
+}
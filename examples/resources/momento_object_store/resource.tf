@@ -11,6 +11,12 @@ resource "momento_valkey_cluster" "example" {
     update = "60m"
     delete = "20m"
   }
+
+  # To move the object store to a new cluster, change the cluster name along with its size: the new cluster is
+  # created, the object store is repointed to it and only then the old cluster is destroyed
+  lifecycle {
+    create_before_destroy = true
+  }
 }
 
 # Creates a Momento object store in us-west-2 region with all optional configs
@@ -21,7 +27,7 @@ resource "momento_object_store" "example" {
   s3_bucket_name      = "s3-bucket-name"
   s3_iam_role_arn     = "s3-iam-role-arn"
   s3_prefix           = "prefix"
-  valkey_cluster_name = "cluster-name"
+  valkey_cluster_name = momento_valkey_cluster.example.cluster_name
   access_logging_config = {
     iam_role_arn   = "cloudwatch-iam-role-arn"
     log_group_name = "log-group-name"
@@ -0,0 +1,2 @@
+# Stores can be imported by specifying their name.
+terraform import momento_store.example store-name
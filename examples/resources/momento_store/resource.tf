@@ -0,0 +1,4 @@
+# Manage a Momento persistent storage store.
+resource "momento_store" "example" {
+  name = "store-name"
+}
@@ -1,4 +1,4 @@
-# Creates a small test cluster in us-west-2 region with all optional configs (timeouts and shard_placements) specified.
+# Creates a small test cluster in us-west-2 region with all optional configs (timeouts, poll_interval, maintenance_window and shard_placements) specified.
 resource "momento_valkey_cluster" "example" {
   cluster_name           = "cluster-name"
   enforce_shard_multi_az = false
@@ -11,10 +11,31 @@ resource "momento_valkey_cluster" "example" {
     replica_availability_zones = ["us-west-2b"]
   }]
 
+  # Check on the cluster more often than the 30s default while waiting for it
+  poll_interval = "15s"
+
+  # Queue instance type and engine changes, which cause failovers, for a weekly window
+  maintenance_window = "sun:05:00-sun:06:00"
+  apply_immediately  = false
+
+  # Create the cluster again if its creation fails, keeping the last failed cluster to debug
+  creation_retry_attempts = 2
+  retain_on_failure       = true
+
   # Updates can take an especially long time, configure as needed
   timeouts {
     create = "20m"
     update = "60m"
     delete = "20m"
   }
-}
\ No newline at end of file
+}
+
+# Spreads shards and replicas across availability zones without listing every placement.
+resource "momento_valkey_cluster" "balanced" {
+  cluster_name           = "balanced-cluster-name"
+  enforce_shard_multi_az = true
+  node_instance_type     = "cache.t3.micro"
+  replication_factor     = 1
+  shard_count            = 3
+  availability_zones     = ["us-west-2a", "us-west-2b", "us-west-2c"]
+}
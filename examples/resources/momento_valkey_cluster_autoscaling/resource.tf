@@ -0,0 +1,24 @@
+# A cluster that starts with 2 shards and is scaled between 2 and 8 shards with 1 to 2 replicas each.
+resource "momento_valkey_cluster" "example" {
+  cluster_name           = "cluster-name"
+  enforce_shard_multi_az = true
+  node_instance_type     = "cache.r7g.large"
+  availability_zones     = ["us-west-2a", "us-west-2b"]
+
+  # Leave the shard count and replication factor to the autoscaler, starting from 2 shards with 1 replica each
+  ignore_autoscaled_size     = true
+  initial_shard_count        = 2
+  initial_replication_factor = 1
+}
+
+resource "momento_valkey_cluster_autoscaling" "example" {
+  cluster_name              = momento_valkey_cluster.example.cluster_name
+  min_shard_count           = 2
+  max_shard_count           = 8
+  min_replication_factor    = 1
+  max_replication_factor    = 2
+  target_memory_utilization = 70
+  target_cpu_utilization    = 60
+  scale_out_cooldown        = "5m"
+  scale_in_cooldown         = "30m"
+}
@@ -0,0 +1,25 @@
+resource "momento_valkey_cluster" "example" {
+  cluster_name           = "cluster-name"
+  enforce_shard_multi_az = true
+  node_instance_type     = "cache.t3.micro"
+  replication_factor     = 2
+  shard_count            = 2
+}
+
+# Promote the best replica of shard 0. Change the trigger to fail over again.
+resource "momento_valkey_cluster_failover" "example" {
+  cluster_name = momento_valkey_cluster.example.cluster_name
+  shard_index  = 0
+
+  triggers = {
+    drill = "2026-10-01"
+  }
+
+  timeouts {
+    create = "30m"
+  }
+}
+
+output "new_primary" {
+  value = momento_valkey_cluster_failover.example.primary_address
+}
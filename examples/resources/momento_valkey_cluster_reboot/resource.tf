@@ -0,0 +1,17 @@
+resource "momento_valkey_cluster" "example" {
+  cluster_name           = "cluster-name"
+  enforce_shard_multi_az = true
+  node_instance_type     = "cache.t3.micro"
+  replication_factor     = 1
+  shard_count            = 3
+}
+
+# Reboot every shard, one at a time. Change the trigger to reboot again.
+resource "momento_valkey_cluster_reboot" "example" {
+  cluster_name  = momento_valkey_cluster.example.cluster_name
+  poll_interval = "15s"
+
+  triggers = {
+    reason = "recover stuck nodes"
+  }
+}
@@ -0,0 +1,22 @@
+# Takes a snapshot of an existing cluster.
+resource "momento_valkey_cluster_snapshot" "example" {
+  cluster_name  = "cluster-name"
+  snapshot_name = "cluster-name-backup"
+
+  timeouts {
+    create = "30m"
+  }
+}
+
+# Seeds a new cluster with the data in the snapshot.
+resource "momento_valkey_cluster" "restored" {
+  cluster_name           = "restored-cluster-name"
+  enforce_shard_multi_az = false
+  node_instance_type     = "cache.t3.micro"
+  replication_factor     = 1
+  shard_count            = 1
+  snapshot_name          = momento_valkey_cluster_snapshot.example.snapshot_name
+
+  # Snapshot the cluster before shards or replicas are removed by later updates
+  snapshot_before_update = true
+}
@@ -0,0 +1,23 @@
+# Request a cluster without blocking the rest of the apply while it is created.
+resource "momento_valkey_cluster" "example" {
+  cluster_name           = "cluster-name"
+  enforce_shard_multi_az = false
+  node_instance_type     = "cache.t3.micro"
+  replication_factor     = 1
+  shard_count            = 1
+  wait_for_ready         = false
+}
+
+# Only resources that reference the waiter wait for the cluster to become active.
+resource "momento_valkey_cluster_waiter" "example" {
+  cluster_name  = momento_valkey_cluster.example.cluster_name
+  poll_interval = "15s"
+
+  timeouts {
+    create = "30m"
+  }
+}
+
+output "configuration_endpoint" {
+  value = "${momento_valkey_cluster_waiter.example.configuration_endpoint}:${momento_valkey_cluster_waiter.example.port}"
+}
@@ -0,0 +1,22 @@
+# Server parameters for Valkey 8.0 clusters used as an LRU cache with keyspace notifications.
+resource "momento_valkey_parameter_group" "example" {
+  name           = "lru-cache"
+  engine_version = "8.0"
+  description    = "Evict least recently used keys and notify on expired keys"
+
+  parameters = {
+    "maxmemory-policy"       = "allkeys-lru"
+    "timeout"                = "300"
+    "notify-keyspace-events" = "Ex"
+  }
+}
+
+resource "momento_valkey_cluster" "example" {
+  cluster_name           = "cluster-name"
+  enforce_shard_multi_az = false
+  node_instance_type     = "cache.t3.micro"
+  replication_factor     = 1
+  shard_count            = 1
+  engine_version         = momento_valkey_parameter_group.example.engine_version
+  parameter_group_name   = momento_valkey_parameter_group.example.name
+}
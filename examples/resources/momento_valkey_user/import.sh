@@ -0,0 +1,2 @@
+# Valkey users can be imported by specifying the cluster name and username separated by a slash.
+terraform import momento_valkey_user.example cluster-name/reader
@@ -0,0 +1,30 @@
+resource "momento_valkey_cluster" "example" {
+  cluster_name           = "cluster-name"
+  enforce_shard_multi_az = false
+  node_instance_type     = "cache.t3.micro"
+  replication_factor     = 1
+  shard_count            = 1
+  auth_mode              = "acl"
+  transit_encryption     = true
+}
+
+# A user with a generated password, which is available in the sensitive `password` attribute.
+resource "momento_valkey_user" "reader" {
+  cluster_name  = momento_valkey_cluster.example.cluster_name
+  username      = "reader"
+  access_string = "on ~* +@read"
+}
+
+variable "writer_password" {
+  type      = string
+  sensitive = true
+}
+
+# A user with a password that is not stored in state. Increase password_version to rotate the password.
+resource "momento_valkey_user" "writer" {
+  cluster_name     = momento_valkey_cluster.example.cluster_name
+  username         = "writer"
+  access_string    = "on ~app:* +@read +@write"
+  password_wo      = var.writer_password
+  password_version = 1
+}
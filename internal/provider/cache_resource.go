@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -19,6 +20,7 @@ var (
 	_ resource.Resource                = &CacheResource{}
 	_ resource.ResourceWithConfigure   = &CacheResource{}
 	_ resource.ResourceWithImportState = &CacheResource{}
+	_ resource.ResourceWithModifyPlan  = &CacheResource{}
 )
 
 func NewCacheResource() resource.Resource {
@@ -27,13 +29,20 @@ func NewCacheResource() resource.Resource {
 
 // CacheResource defines the resource implementation.
 type CacheResource struct {
-	client *momento.CacheClient
+	client        *momento.CacheClient
+	httpClient    *http.Client
+	httpEndpoint  string
+	httpAuthToken string
+	v2Endpoint    string
+	defaultTags   map[string]string
 }
 
 // CacheResourceModel describes the resource data model.
 type CacheResourceModel struct {
-	Name types.String `tfsdk:"name"`
-	Id   types.String `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	Id      types.String `tfsdk:"id"`
+	Tags    types.Map    `tfsdk:"tags"`
+	TagsAll types.Map    `tfsdk:"tags_all"`
 }
 
 func (r *CacheResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -60,6 +69,16 @@ func (r *CacheResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"tags": schema.MapAttribute{
+				MarkdownDescription: "Tags to assign to the cache. Tags override provider `default_tags` with the same key. Changing the tags updates the cache in place.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"tags_all": schema.MapAttribute{
+				MarkdownDescription: "All tags of the cache, including those inherited from the provider `default_tags`.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -84,6 +103,20 @@ func (r *CacheResource) Configure(ctx context.Context, req resource.ConfigureReq
 	client := clients.cache
 
 	r.client = &client
+	r.httpClient = clients.httpClient
+	r.httpEndpoint = clients.httpEndpoint
+	r.httpAuthToken = clients.httpAuthToken
+	r.v2Endpoint = clients.v2Endpoint
+	r.defaultTags = clients.defaultTags
+}
+
+func (r *CacheResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Skip Delete (plan null).
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(planTagsAll(ctx, r.defaultTags, &resp.Plan)...)
 }
 
 func (r *CacheResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -120,6 +153,23 @@ func (r *CacheResource) Create(ctx context.Context, req resource.CreateRequest,
 	// Map response body to schema and populate computed attribute values
 	plan.Id = types.StringValue(plan.Name.ValueString())
 
+	// Tags are assigned once the cache exists
+	tagsAllValue, diags := tagsAll(ctx, r.defaultTags, plan.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.TagsAll = tagsAllValue
+	if len(plan.TagsAll.Elements()) > 0 {
+		if err := putResourceTags(*r.httpClient, "cache/"+plan.Name.ValueString(), tagsFromMap(plan.TagsAll), r.httpEndpoint, r.httpAuthToken); err != nil {
+			// The cache was created, so it is saved without its tags, which are assigned by the next apply
+			plan.TagsAll = types.MapNull(types.StringType)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to tag cache, got error: %s", err))
+			return
+		}
+	}
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -148,6 +198,15 @@ func (r *CacheResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	state.Id = types.StringValue(state.Name.ValueString())
 
+	if tagsApiRequired(r.v2Endpoint, state.TagsAll) {
+		tags, err := describeResourceTags(*r.httpClient, "cache/"+state.Name.ValueString(), r.httpEndpoint, r.httpAuthToken)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read cache tags, got error: %s", err))
+			return
+		}
+		resp.Diagnostics.Append(refreshTags(ctx, tags, r.defaultTags, &state.Tags, &state.TagsAll)...)
+	}
+
 	// Set refreshed state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 
@@ -157,16 +216,31 @@ func (r *CacheResource) Read(ctx context.Context, req resource.ReadRequest, resp
 }
 
 func (r *CacheResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var state CacheResourceModel
+	var plan, state CacheResourceModel
 
-	// Read Terraform prior state data into the model
+	// Retrieve values from the plan. Changing the name replaces the cache, so only the tags are updated.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	resp.Diagnostics.AddError("Internal Error", "Cache resource does not support updates")
+	tagsAllValue, diags := tagsAll(ctx, r.defaultTags, plan.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.TagsAll = tagsAllValue
+	if tagsApiRequired(r.v2Endpoint, plan.TagsAll, state.TagsAll) {
+		if err := putResourceTags(*r.httpClient, "cache/"+plan.Name.ValueString(), tagsFromMap(plan.TagsAll), r.httpEndpoint, r.httpAuthToken); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update cache tags, got error: %s", err))
+			return
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *CacheResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
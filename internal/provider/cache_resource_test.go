@@ -1,12 +1,21 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"io"
+	"net/http"
+	"strings"
 	"testing"
 
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/momentohq/client-sdk-go/momento"
+	"github.com/momentohq/client-sdk-go/responses"
 )
 
 func TestCreateCacheResource(t *testing.T) {
@@ -44,6 +53,20 @@ func TestCreateCacheResource(t *testing.T) {
 					resource.TestCheckResourceAttr("momento_cache.test", "id", cacheName1),
 				),
 			},
+			// Changing the tags should update the cache in place
+			{
+				Config: testAccCacheResourceConfigWithTags(cacheName1, "test"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("momento_cache.test", "Update"),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("momento_cache.test", "name", cacheName1),
+					resource.TestCheckResourceAttr("momento_cache.test", "tags.environment", "test"),
+					resource.TestCheckResourceAttr("momento_cache.test", "tags_all.environment", "test"),
+				),
+			},
 			// Updating the config with new cache name should destroy the old cache and create a new one
 			{
 				Config: testAccCacheResourceConfig(cacheName2),
@@ -75,3 +98,98 @@ resource "momento_cache" "test" {
 }
 `, name)
 }
+
+func testAccCacheResourceConfigWithTags(name string, environment string) string {
+	return fmt.Sprintf(`
+resource "momento_cache" "test" {
+  name = %[1]q
+
+  tags = {
+    environment = %[2]q
+  }
+}
+`, name, environment)
+}
+
+// testCacheClient lists the given caches. Calls to other methods panic.
+type testCacheClient struct {
+	momento.CacheClient
+	caches []string
+}
+
+func (c testCacheClient) ListCaches(ctx context.Context, r *momento.ListCachesRequest) (responses.ListCachesResponse, error) {
+	caches := make([]responses.CacheInfo, len(c.caches))
+	for i, name := range c.caches {
+		caches[i] = responses.NewCacheInfo(name)
+	}
+	return responses.NewListCachesSuccess("", caches), nil
+}
+
+// testTagsTransport answers every tags API request with the given tags and records the requested URLs.
+type testTagsTransport struct {
+	tags     string
+	requests []string
+}
+
+func (tr *testTagsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	tr.requests = append(tr.requests, req.URL.String())
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(tr.tags)), Request: req}, nil
+}
+
+func TestCacheResourceReadWithoutV2Credentials(t *testing.T) {
+	ctx := context.Background()
+	tagsType := tftypes.Map{ElementType: tftypes.String}
+	noTags := tftypes.NewValue(tagsType, nil)
+	tags := tftypes.NewValue(tagsType, map[string]tftypes.Value{"team": tftypes.NewValue(tftypes.String, "platform")})
+	tests := []struct {
+		name         string
+		v2Endpoint   string
+		tags         tftypes.Value
+		wantRequests int
+	}{
+		{name: "without tags", tags: noTags},
+		{name: "with tags", tags: tags, wantRequests: 1},
+		{name: "with a V2 endpoint", v2Endpoint: "cell-4-us-west-2-1.prod.a.momentohq.com", tags: noTags, wantRequests: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Without a V2 API key and endpoint, the provider builds the tags API endpoint from an empty endpoint
+			transport := &testTagsTransport{tags: `{"tags": {"team": "platform"}}`}
+			var client momento.CacheClient = testCacheClient{caches: []string{"cache"}}
+			r := &CacheResource{
+				client:       &client,
+				httpClient:   &http.Client{Transport: transport},
+				httpEndpoint: "https://api.cache.",
+				v2Endpoint:   tt.v2Endpoint,
+			}
+			schemaResp := &fwresource.SchemaResponse{}
+			r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+			schemaType := schemaResp.Schema.Type().TerraformType(ctx)
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+				"name":     tftypes.NewValue(tftypes.String, "cache"),
+				"id":       tftypes.NewValue(tftypes.String, "cache"),
+				"tags":     tt.tags,
+				"tags_all": tt.tags,
+			})}
+
+			resp := &fwresource.ReadResponse{State: state}
+			r.Read(ctx, fwresource.ReadRequest{State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if len(transport.requests) != tt.wantRequests {
+				t.Errorf("tags API requests = %v, want %d", transport.requests, tt.wantRequests)
+			}
+			var refreshed CacheResourceModel
+			if diags := resp.State.Get(ctx, &refreshed); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if refreshed.Name.ValueString() != "cache" {
+				t.Errorf("name = %s, want cache", refreshed.Name)
+			}
+			if tt.wantRequests == 0 && (!refreshed.Tags.IsNull() || !refreshed.TagsAll.IsNull()) {
+				t.Errorf("tags = %s, tags_all = %s, want them left as configured", refreshed.Tags, refreshed.TagsAll)
+			}
+		})
+	}
+}
@@ -3,9 +3,12 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/momentohq/client-sdk-go/momento"
 	"github.com/momentohq/client-sdk-go/responses"
@@ -28,8 +31,10 @@ type CachesDataSource struct {
 
 // CachesDataSourceModel describes the data source data model.
 type CachesDataSourceModel struct {
-	Id     types.String                 `tfsdk:"id"`
-	Caches []CachesDataSourceCacheModel `tfsdk:"caches"`
+	Id         types.String                 `tfsdk:"id"`
+	NamePrefix types.String                 `tfsdk:"name_prefix"`
+	NameRegex  types.String                 `tfsdk:"name_regex"`
+	Caches     []CachesDataSourceCacheModel `tfsdk:"caches"`
 }
 
 type CachesDataSourceCacheModel struct {
@@ -50,6 +55,14 @@ func (d *CachesDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 				Description: "Placeholder identifier attribute.",
 				Computed:    true,
 			},
+			"name_prefix": schema.StringAttribute{
+				MarkdownDescription: "Only return caches whose name starts with this prefix.",
+				Optional:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only return caches whose name matches this regular expression.",
+				Optional:            true,
+			},
 			"caches": schema.ListNestedAttribute{
 				Description: "List of caches.",
 				Computed:    true,
@@ -120,6 +133,12 @@ func (d *CachesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
+	caches, err = filterNames(caches, data.NamePrefix, data.NameRegex)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid value", fmt.Sprintf("Unable to compile name_regex: %s", err))
+		return
+	}
+
 	// Save data into the model
 	for _, cache := range caches {
 		cacheState := CachesDataSourceCacheModel{
@@ -149,3 +168,26 @@ func listCaches(ctx context.Context, client momento.CacheClient) ([]string, erro
 	}
 	return caches, nil
 }
+
+// filterNames returns the names matching both the optional prefix and the optional regular expression.
+func filterNames(names []string, prefix types.String, regex types.String) ([]string, error) {
+	var re *regexp.Regexp
+	if !regex.IsNull() && !regex.IsUnknown() {
+		compiled, err := regexp.Compile(regex.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		re = compiled
+	}
+	var filtered []string
+	for _, name := range names {
+		if !prefix.IsNull() && !prefix.IsUnknown() && !strings.HasPrefix(name, prefix.ValueString()) {
+			continue
+		}
+		if re != nil && !re.MatchString(name) {
+			continue
+		}
+		filtered = append(filtered, name)
+	}
+	return filtered, nil
+}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -31,6 +32,14 @@ func TestListCachesDataSource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.momento_caches.test", "caches.#"),
 				),
 			},
+			// Filtering by the exact cache name should return only that cache
+			{
+				Config: testAccCacheResourceConfig(cacheName) + testAccCachesDataSourceFilteredConfig(cacheName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.momento_caches.filtered", "caches.#", "1"),
+					resource.TestCheckResourceAttr("data.momento_caches.filtered", "caches.0.name", cacheName),
+				),
+			},
 		},
 	})
 }
@@ -39,3 +48,12 @@ const testAccCachesDataSourceConfig = `
 data "momento_caches" "test" {
 }
 `
+
+func testAccCachesDataSourceFilteredConfig(name string) string {
+	return fmt.Sprintf(`
+data "momento_caches" "filtered" {
+  name_prefix = %[1]q
+  depends_on  = [momento_cache.test]
+}
+`, name)
+}
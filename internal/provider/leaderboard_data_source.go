@@ -0,0 +1,350 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/momentohq/client-sdk-go/momento"
+	"github.com/momentohq/client-sdk-go/responses"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource                   = &LeaderboardDataSource{}
+	_ datasource.DataSourceWithConfigure      = &LeaderboardDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &LeaderboardDataSource{}
+)
+
+func NewLeaderboardDataSource() datasource.DataSource {
+	return &LeaderboardDataSource{}
+}
+
+// LeaderboardDataSource defines the data source implementation.
+type LeaderboardDataSource struct {
+	client *momento.PreviewLeaderboardClient
+}
+
+// LeaderboardDataSourceModel describes the data source data model.
+type LeaderboardDataSourceModel struct {
+	Id         types.String                        `tfsdk:"id"`
+	CacheName  types.String                        `tfsdk:"cache_name"`
+	Name       types.String                        `tfsdk:"name"`
+	TopN       types.Int64                         `tfsdk:"top_n"`
+	BottomN    types.Int64                         `tfsdk:"bottom_n"`
+	ScoreRange *LeaderboardScoreRangeModel         `tfsdk:"score_range"`
+	Ids        []types.Int64                       `tfsdk:"ids"`
+	Order      types.String                        `tfsdk:"order"`
+	Length     types.Int64                         `tfsdk:"length"`
+	Elements   []LeaderboardDataSourceElementModel `tfsdk:"elements"`
+}
+
+type LeaderboardScoreRangeModel struct {
+	MinScore types.Float64 `tfsdk:"min_score"`
+	MaxScore types.Float64 `tfsdk:"max_score"`
+	Offset   types.Int64   `tfsdk:"offset"`
+	Count    types.Int64   `tfsdk:"count"`
+}
+
+type LeaderboardDataSourceElementModel struct {
+	Id    types.Int64   `tfsdk:"id"`
+	Score types.Float64 `tfsdk:"score"`
+	Rank  types.Int64   `tfsdk:"rank"`
+}
+
+func (d *LeaderboardDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_leaderboard"
+}
+
+func (d *LeaderboardDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The current standings of a Momento serverless leaderboard. At most one of `top_n`, `bottom_n`, `score_range` or `ids` may be set to select the returned elements. If none is set, only the length is returned.",
+
+		Attributes: map[string]schema.Attribute{
+			// The testing framework requires an id attribute to be present in every data source and resource
+			"id": schema.StringAttribute{
+				Description: "The ID of the leaderboard, in the form `cache_name/name`.",
+				Computed:    true,
+			},
+			"cache_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the cache.",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the leaderboard.",
+				Required:            true,
+			},
+			"top_n": schema.Int64Attribute{
+				MarkdownDescription: "Return the N elements with the highest scores, highest first.",
+				Optional:            true,
+			},
+			"bottom_n": schema.Int64Attribute{
+				MarkdownDescription: "Return the N elements with the lowest scores, lowest first.",
+				Optional:            true,
+			},
+			"score_range": schema.SingleNestedAttribute{
+				MarkdownDescription: "Return the elements with scores in the given range, sorted according to `order`.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"min_score": schema.Float64Attribute{
+						MarkdownDescription: "The inclusive lower bound of the score range. Unbounded if not set.",
+						Optional:            true,
+					},
+					"max_score": schema.Float64Attribute{
+						MarkdownDescription: "The exclusive upper bound of the score range. Unbounded if not set.",
+						Optional:            true,
+					},
+					"offset": schema.Int64Attribute{
+						MarkdownDescription: "The number of matching elements to skip.",
+						Optional:            true,
+					},
+					"count": schema.Int64Attribute{
+						MarkdownDescription: "The maximum number of elements to return.",
+						Optional:            true,
+					},
+				},
+			},
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "Return the ranks and scores of these element IDs, of which there must be at least one. IDs that are not in the leaderboard are omitted.",
+				ElementType:         types.Int64Type,
+				Optional:            true,
+			},
+			"order": schema.StringAttribute{
+				MarkdownDescription: "The order used to rank elements for `score_range` and `ids`. One of `ascending` (the default) or `descending`.",
+				Optional:            true,
+			},
+			"length": schema.Int64Attribute{
+				MarkdownDescription: "The number of elements in the leaderboard.",
+				Computed:            true,
+			},
+			"elements": schema.ListNestedAttribute{
+				MarkdownDescription: "The selected elements.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "The element ID.",
+							Computed:            true,
+						},
+						"score": schema.Float64Attribute{
+							MarkdownDescription: "The element score.",
+							Computed:            true,
+						},
+						"rank": schema.Int64Attribute{
+							MarkdownDescription: "The 0-based rank of the element.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *LeaderboardDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(MomentoClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected MomentoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	client := clients.leaderboard
+
+	d.client = &client
+}
+
+func (d *LeaderboardDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	// Attributes are read individually as framework types, since any of them may be unknown during validation.
+	var topN, bottomN, offset, count types.Int64
+	var scoreRange types.Object
+	var ids types.List
+	var order types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("top_n"), &topN)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("bottom_n"), &bottomN)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("score_range"), &scoreRange)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("ids"), &ids)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("order"), &order)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	selectors := 0
+	for _, set := range []bool{!topN.IsNull(), !bottomN.IsNull(), !scoreRange.IsNull(), !ids.IsNull()} {
+		if set {
+			selectors++
+		}
+	}
+	if selectors > 1 {
+		resp.Diagnostics.AddError("Invalid Attribute Combination", "At most one of top_n, bottom_n, score_range or ids may be set.")
+	}
+
+	for _, n := range []struct {
+		name  string
+		value types.Int64
+	}{{"top_n", topN}, {"bottom_n", bottomN}} {
+		if !n.value.IsNull() && !n.value.IsUnknown() && (n.value.ValueInt64() <= 0 || n.value.ValueInt64() > leaderboardBatchSize) {
+			resp.Diagnostics.AddAttributeError(path.Root(n.name), "Invalid value", fmt.Sprintf("%s must be between 1 and %d.", n.name, leaderboardBatchSize))
+		}
+	}
+
+	if !scoreRange.IsNull() && !scoreRange.IsUnknown() {
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("score_range").AtName("offset"), &offset)...)
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("score_range").AtName("count"), &count)...)
+		if !offset.IsNull() && !offset.IsUnknown() && offset.ValueInt64() < 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("score_range").AtName("offset"), "Invalid value", "Offset must be a non-negative integer.")
+		}
+		if !count.IsNull() && !count.IsUnknown() && (count.ValueInt64() <= 0 || count.ValueInt64() > leaderboardBatchSize) {
+			resp.Diagnostics.AddAttributeError(path.Root("score_range").AtName("count"), "Invalid value", fmt.Sprintf("Count must be between 1 and %d.", leaderboardBatchSize))
+		}
+	}
+
+	if !ids.IsNull() && !ids.IsUnknown() {
+		if len(ids.Elements()) == 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("ids"), "Invalid value", "ids must contain at least one element ID.")
+		}
+		for i, element := range ids.Elements() {
+			id, ok := element.(types.Int64)
+			if ok && !id.IsNull() && !id.IsUnknown() && (id.ValueInt64() < 0 || id.ValueInt64() > 0xFFFFFFFF) {
+				resp.Diagnostics.AddAttributeError(path.Root("ids").AtListIndex(i), "Invalid value", "Element IDs must be unsigned 32-bit integers.")
+			}
+		}
+	}
+
+	if !order.IsNull() && !order.IsUnknown() {
+		if _, err := parseLeaderboardOrder(order.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("order"), "Invalid value", err.Error())
+		}
+	}
+}
+
+func (d *LeaderboardDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data LeaderboardDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Retrieve data from the API
+	client := *d.client
+	length, err := leaderboardLength(ctx, client, data.CacheName.ValueString(), data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read leaderboard length, got error: %s", err))
+		return
+	}
+
+	leaderboard, err := client.Leaderboard(ctx, &momento.LeaderboardRequest{
+		LeaderboardName: data.Name.ValueString(),
+		CacheName:       data.CacheName.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create leaderboard client, got error: %s", err))
+		return
+	}
+
+	order := momento.ASCENDING
+	if !data.Order.IsNull() {
+		order, err = parseLeaderboardOrder(data.Order.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("order"), "Invalid value", err.Error())
+			return
+		}
+	}
+
+	var fetchResp responses.LeaderboardFetchResponse
+	switch {
+	case !data.TopN.IsNull():
+		descending := momento.DESCENDING
+		fetchResp, err = leaderboard.FetchByRank(ctx, momento.LeaderboardFetchByRankRequest{
+			StartRank: 0,
+			EndRank:   uint32(data.TopN.ValueInt64()),
+			Order:     &descending,
+		})
+	case !data.BottomN.IsNull():
+		ascending := momento.ASCENDING
+		fetchResp, err = leaderboard.FetchByRank(ctx, momento.LeaderboardFetchByRankRequest{
+			StartRank: 0,
+			EndRank:   uint32(data.BottomN.ValueInt64()),
+			Order:     &ascending,
+		})
+	case data.ScoreRange != nil:
+		request := momento.LeaderboardFetchByScoreRequest{Order: &order}
+		if !data.ScoreRange.MinScore.IsNull() {
+			minScore := data.ScoreRange.MinScore.ValueFloat64()
+			request.MinScore = &minScore
+		}
+		if !data.ScoreRange.MaxScore.IsNull() {
+			maxScore := data.ScoreRange.MaxScore.ValueFloat64()
+			request.MaxScore = &maxScore
+		}
+		if !data.ScoreRange.Offset.IsNull() {
+			offset := uint32(data.ScoreRange.Offset.ValueInt64())
+			request.Offset = &offset
+		}
+		if !data.ScoreRange.Count.IsNull() {
+			count := uint32(data.ScoreRange.Count.ValueInt64())
+			request.Count = &count
+		}
+		fetchResp, err = leaderboard.FetchByScore(ctx, request)
+	case data.Ids != nil:
+		ids := make([]uint32, len(data.Ids))
+		for i, id := range data.Ids {
+			ids[i] = uint32(id.ValueInt64())
+		}
+		fetchResp, err = leaderboard.GetRank(ctx, momento.LeaderboardGetRankRequest{Ids: ids, Order: &order})
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch leaderboard elements, got error: %s", err))
+		return
+	}
+
+	// Save data into the model
+	data.Elements = []LeaderboardDataSourceElementModel{}
+	if fetchResp != nil {
+		r, ok := fetchResp.(*responses.LeaderboardFetchSuccess)
+		if !ok {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch leaderboard elements, got unknown response type: %T", fetchResp))
+			return
+		}
+		for _, element := range r.Values() {
+			data.Elements = append(data.Elements, LeaderboardDataSourceElementModel{
+				Id:    types.Int64Value(int64(element.Id)),
+				Score: types.Float64Value(element.Score),
+				Rank:  types.Int64Value(int64(element.Rank)),
+			})
+		}
+	}
+
+	data.Id = types.StringValue(leaderboardId(data.CacheName.ValueString(), data.Name.ValueString()))
+	data.Length = types.Int64Value(int64(length))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func parseLeaderboardOrder(order string) (momento.LeaderboardOrder, error) {
+	switch order {
+	case "ascending":
+		return momento.ASCENDING, nil
+	case "descending":
+		return momento.DESCENDING, nil
+	default:
+		return momento.ASCENDING, fmt.Errorf("order must be one of \"ascending\" or \"descending\", got: %q", order)
+	}
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestLeaderboardDataSource(t *testing.T) {
+	cacheName := "terraform-provider-momento-test-" + acctest.RandString(8)
+	leaderboardName := "terraform-provider-momento-test-" + acctest.RandString(8)
+	seed := testAccLeaderboardElementsResourceConfig(cacheName, leaderboardName, `{ "1" = 10, "2" = 20, "3" = 30 }`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Seed the leaderboard to test the data source
+			{
+				Config: seed,
+			},
+			// Top N
+			{
+				Config: seed + testAccLeaderboardDataSourceConfig(`top_n = 2`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.momento_leaderboard.test", "length", "3"),
+					resource.TestCheckResourceAttr("data.momento_leaderboard.test", "elements.#", "2"),
+					resource.TestCheckResourceAttr("data.momento_leaderboard.test", "elements.0.id", "3"),
+					resource.TestCheckResourceAttr("data.momento_leaderboard.test", "elements.0.rank", "0"),
+				),
+			},
+			// Bottom N
+			{
+				Config: seed + testAccLeaderboardDataSourceConfig(`bottom_n = 1`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.momento_leaderboard.test", "elements.#", "1"),
+					resource.TestCheckResourceAttr("data.momento_leaderboard.test", "elements.0.id", "1"),
+				),
+			},
+			// Score range
+			{
+				Config: seed + testAccLeaderboardDataSourceConfig(`score_range = { min_score = 15, max_score = 35 }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.momento_leaderboard.test", "elements.#", "2"),
+					resource.TestCheckResourceAttr("data.momento_leaderboard.test", "elements.0.id", "2"),
+				),
+			},
+			// Ranks of specific IDs
+			{
+				Config: seed + testAccLeaderboardDataSourceConfig(`
+  ids   = [1]
+  order = "descending"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.momento_leaderboard.test", "elements.#", "1"),
+					resource.TestCheckResourceAttr("data.momento_leaderboard.test", "elements.0.rank", "2"),
+					resource.TestCheckResourceAttr("data.momento_leaderboard.test", "elements.0.score", "10"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLeaderboardDataSourceConfig(selector string) string {
+	return fmt.Sprintf(`
+data "momento_leaderboard" "test" {
+  cache_name = momento_leaderboard_elements.test.cache_name
+  name       = momento_leaderboard_elements.test.leaderboard_name
+  %[1]s
+}
+`, selector)
+}
+
+func TestLeaderboardDataSourceValidateConfigIds(t *testing.T) {
+	ctx := context.Background()
+	d := &LeaderboardDataSource{}
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	schemaType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	idsType := tftypes.List{ElementType: tftypes.Number}
+
+	tests := []struct {
+		name    string
+		ids     []tftypes.Value
+		wantErr bool
+	}{
+		{name: "empty", ids: []tftypes.Value{}, wantErr: true},
+		{name: "one id", ids: []tftypes.Value{tftypes.NewValue(tftypes.Number, 1)}},
+		{name: "id out of range", ids: []tftypes.Value{tftypes.NewValue(tftypes.Number, 0x100000000)}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := map[string]tftypes.Value{}
+			for name, attributeType := range schemaType.AttributeTypes {
+				values[name] = tftypes.NewValue(attributeType, nil)
+			}
+			values["cache_name"] = tftypes.NewValue(tftypes.String, "cache")
+			values["name"] = tftypes.NewValue(tftypes.String, "leaderboard")
+			values["ids"] = tftypes.NewValue(idsType, tt.ids)
+
+			resp := &datasource.ValidateConfigResponse{}
+			d.ValidateConfig(ctx, datasource.ValidateConfigRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaType, values)}}, resp)
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("diagnostics = %v, want error %v", resp.Diagnostics, tt.wantErr)
+			}
+			for _, diagnostic := range resp.Diagnostics.Errors() {
+				withPath, ok := diagnostic.(diag.DiagnosticWithPath)
+				if !ok || !(withPath.Path().Equal(path.Root("ids")) || withPath.Path().ParentPath().Equal(path.Root("ids"))) {
+					t.Errorf("error %q is not on ids", diagnostic.Summary())
+				}
+			}
+		})
+	}
+}
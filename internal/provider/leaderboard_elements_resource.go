@@ -0,0 +1,428 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/momentohq/client-sdk-go/momento"
+	"github.com/momentohq/client-sdk-go/responses"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &LeaderboardElementsResource{}
+	_ resource.ResourceWithConfigure      = &LeaderboardElementsResource{}
+	_ resource.ResourceWithValidateConfig = &LeaderboardElementsResource{}
+	_ resource.ResourceWithImportState    = &LeaderboardElementsResource{}
+)
+
+// leaderboardBatchSize is the maximum number of elements Momento accepts in a single leaderboard request.
+const leaderboardBatchSize = 8192
+
+func NewLeaderboardElementsResource() resource.Resource {
+	return &LeaderboardElementsResource{}
+}
+
+// LeaderboardElementsResource defines the resource implementation.
+type LeaderboardElementsResource struct {
+	client *momento.PreviewLeaderboardClient
+}
+
+// LeaderboardElementsResourceModel describes the resource data model.
+type LeaderboardElementsResourceModel struct {
+	Id                     types.String             `tfsdk:"id"`
+	CacheName              types.String             `tfsdk:"cache_name"`
+	LeaderboardName        types.String             `tfsdk:"leaderboard_name"`
+	Elements               map[string]types.Float64 `tfsdk:"elements"`
+	IgnoreUnlistedElements types.Bool               `tfsdk:"ignore_unlisted_elements"`
+}
+
+func (l *LeaderboardElementsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_leaderboard_elements"
+}
+
+func (l *LeaderboardElementsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The elements and scores of a Momento serverless leaderboard.",
+
+		Attributes: map[string]schema.Attribute{
+			// The testing framework requires an id attribute to be present in every data source and resource
+			"id": schema.StringAttribute{
+				Description: "The ID of the leaderboard, in the form `cache_name/leaderboard_name`.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cache_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the cache.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"leaderboard_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the leaderboard.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"elements": schema.MapAttribute{
+				MarkdownDescription: "Map of element ID to score. Element IDs must be unsigned 32-bit integers written without leading zeros.",
+				ElementType:         types.Float64Type,
+				Required:            true,
+			},
+			"ignore_unlisted_elements": schema.BoolAttribute{
+				MarkdownDescription: "When true, only the element IDs listed in `elements` are managed and any other elements in the leaderboard are left alone. " +
+					"When false (the default), the leaderboard is managed exclusively and elements not listed are removed.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (l *LeaderboardElementsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(MomentoClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected MomentoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	client := clients.leaderboard
+
+	l.client = &client
+}
+
+func (l *LeaderboardElementsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// Only the element IDs are validated, and the elements may not be known yet, e.g. when they are
+	// built from another resource's attributes.
+	var elements types.Map
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("elements"), &elements)...)
+
+	if resp.Diagnostics.HasError() || elements.IsNull() || elements.IsUnknown() {
+		return
+	}
+
+	for id := range elements.Elements() {
+		if !isLeaderboardElementId(id) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("elements").AtMapKey(id),
+				"Invalid element ID",
+				fmt.Sprintf("Element ID %q must be an unsigned 32-bit integer written without leading zeros.", id),
+			)
+		}
+	}
+}
+
+func (l *LeaderboardElementsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan LeaderboardElementsResourceModel
+
+	// Retrieve values from the plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	leaderboard, err := l.leaderboard(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create leaderboard client, got error: %s", err))
+		return
+	}
+
+	if err := upsertLeaderboardElements(ctx, leaderboard, plan.Elements); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to upsert leaderboard elements, got error: %s", err))
+		return
+	}
+
+	// When managing the leaderboard exclusively, remove anything that was there before.
+	if !plan.IgnoreUnlistedElements.ValueBool() {
+		existing, err := fetchAllLeaderboardElements(ctx, leaderboard)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch leaderboard elements, got error: %s", err))
+			return
+		}
+		var unlisted []uint32
+		for id := range existing {
+			if _, ok := plan.Elements[id]; !ok {
+				unlisted = append(unlisted, parseLeaderboardElementId(id))
+			}
+		}
+		if err := removeLeaderboardElements(ctx, leaderboard, unlisted); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove leaderboard elements, got error: %s", err))
+			return
+		}
+	}
+
+	// Map response body to schema and populate computed attribute values
+	plan.Id = types.StringValue(leaderboardId(plan.CacheName.ValueString(), plan.LeaderboardName.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (l *LeaderboardElementsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state LeaderboardElementsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	leaderboard, err := l.leaderboard(ctx, &state)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create leaderboard client, got error: %s", err))
+		return
+	}
+
+	// Only look up the managed IDs when other elements are ignored, otherwise fetch the whole
+	// leaderboard so that unlisted elements show up as drift.
+	var elements map[string]types.Float64
+	if state.IgnoreUnlistedElements.ValueBool() {
+		ids := make([]uint32, 0, len(state.Elements))
+		for id := range state.Elements {
+			ids = append(ids, parseLeaderboardElementId(id))
+		}
+		elements, err = fetchLeaderboardElementsById(ctx, leaderboard, ids)
+	} else {
+		elements, err = fetchAllLeaderboardElements(ctx, leaderboard)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch leaderboard elements, got error: %s", err))
+		return
+	}
+
+	state.Id = types.StringValue(leaderboardId(state.CacheName.ValueString(), state.LeaderboardName.ValueString()))
+	state.Elements = elements
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (l *LeaderboardElementsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state LeaderboardElementsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan LeaderboardElementsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	leaderboard, err := l.leaderboard(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create leaderboard client, got error: %s", err))
+		return
+	}
+
+	// Only send elements that are new or whose score changed
+	changed := make(map[string]types.Float64)
+	for id, score := range plan.Elements {
+		if current, ok := state.Elements[id]; !ok || !current.Equal(score) {
+			changed[id] = score
+		}
+	}
+	if err := upsertLeaderboardElements(ctx, leaderboard, changed); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to upsert leaderboard elements, got error: %s", err))
+		return
+	}
+
+	// Remove elements dropped from the configuration. In exclusive mode the refreshed state
+	// also holds any unlisted elements, so those are removed as well.
+	var removed []uint32
+	for id := range state.Elements {
+		if _, ok := plan.Elements[id]; !ok {
+			removed = append(removed, parseLeaderboardElementId(id))
+		}
+	}
+	if err := removeLeaderboardElements(ctx, leaderboard, removed); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove leaderboard elements, got error: %s", err))
+		return
+	}
+
+	plan.Id = types.StringValue(leaderboardId(plan.CacheName.ValueString(), plan.LeaderboardName.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (l *LeaderboardElementsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state LeaderboardElementsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	leaderboard, err := l.leaderboard(ctx, &state)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create leaderboard client, got error: %s", err))
+		return
+	}
+
+	// Only remove the managed elements, the leaderboard itself belongs to momento_leaderboard
+	ids := make([]uint32, 0, len(state.Elements))
+	for id := range state.Elements {
+		ids = append(ids, parseLeaderboardElementId(id))
+	}
+	if err := removeLeaderboardElements(ctx, leaderboard, ids); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove leaderboard elements, got error: %s", err))
+		return
+	}
+}
+
+// Importing always manages the leaderboard exclusively, since there is no configuration yet to
+// tell which elements should be managed.
+func (l *LeaderboardElementsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	cacheName, leaderboardName, err := parseLeaderboardId(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cache_name"), cacheName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("leaderboard_name"), leaderboardName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ignore_unlisted_elements"), false)...)
+}
+
+func (l *LeaderboardElementsResource) leaderboard(ctx context.Context, model *LeaderboardElementsResourceModel) (momento.Leaderboard, error) {
+	client := *l.client
+	return client.Leaderboard(ctx, &momento.LeaderboardRequest{
+		LeaderboardName: model.LeaderboardName.ValueString(),
+		CacheName:       model.CacheName.ValueString(),
+	})
+}
+
+// isLeaderboardElementId reports whether id is an element ID in the form Read writes it back, since
+// IDs such as "01" would show a permanent diff and collapse onto the same element as "1".
+func isLeaderboardElementId(id string) bool {
+	parsed, err := strconv.ParseUint(id, 10, 32)
+	return err == nil && strconv.FormatUint(parsed, 10) == id
+}
+
+// parseLeaderboardElementId parses an element ID map key. Keys are checked in ValidateConfig.
+func parseLeaderboardElementId(id string) uint32 {
+	parsed, _ := strconv.ParseUint(id, 10, 32)
+	return uint32(parsed)
+}
+
+func upsertLeaderboardElements(ctx context.Context, leaderboard momento.Leaderboard, elements map[string]types.Float64) error {
+	ids := make([]string, 0, len(elements))
+	for id := range elements {
+		ids = append(ids, id)
+	}
+	// Sort so that batches are deterministic between runs
+	sort.Strings(ids)
+
+	for start := 0; start < len(ids); start += leaderboardBatchSize {
+		end := min(start+leaderboardBatchSize, len(ids))
+		batch := make([]momento.LeaderboardUpsertElement, 0, end-start)
+		for _, id := range ids[start:end] {
+			batch = append(batch, momento.LeaderboardUpsertElement{
+				Id:    parseLeaderboardElementId(id),
+				Score: elements[id].ValueFloat64(),
+			})
+		}
+		resp, err := leaderboard.Upsert(ctx, momento.LeaderboardUpsertRequest{Elements: batch})
+		if err != nil {
+			return err
+		}
+		if _, ok := resp.(*responses.LeaderboardUpsertSuccess); !ok {
+			return fmt.Errorf("unexpected response type %T", resp)
+		}
+	}
+	return nil
+}
+
+func removeLeaderboardElements(ctx context.Context, leaderboard momento.Leaderboard, ids []uint32) error {
+	for start := 0; start < len(ids); start += leaderboardBatchSize {
+		end := min(start+leaderboardBatchSize, len(ids))
+		resp, err := leaderboard.RemoveElements(ctx, momento.LeaderboardRemoveElementsRequest{Ids: ids[start:end]})
+		if err != nil {
+			return err
+		}
+		if _, ok := resp.(*responses.LeaderboardRemoveElementsSuccess); !ok {
+			return fmt.Errorf("unexpected response type %T", resp)
+		}
+	}
+	return nil
+}
+
+// fetchAllLeaderboardElements pages through the whole leaderboard by rank.
+func fetchAllLeaderboardElements(ctx context.Context, leaderboard momento.Leaderboard) (map[string]types.Float64, error) {
+	elements := make(map[string]types.Float64)
+	for startRank := uint32(0); ; startRank += leaderboardBatchSize {
+		resp, err := leaderboard.FetchByRank(ctx, momento.LeaderboardFetchByRankRequest{
+			StartRank: startRank,
+			EndRank:   startRank + leaderboardBatchSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+		r, ok := resp.(*responses.LeaderboardFetchSuccess)
+		if !ok {
+			return nil, fmt.Errorf("unexpected response type %T", resp)
+		}
+		for _, element := range r.Values() {
+			elements[strconv.FormatUint(uint64(element.Id), 10)] = types.Float64Value(element.Score)
+		}
+		if len(r.Values()) < leaderboardBatchSize {
+			return elements, nil
+		}
+	}
+}
+
+// fetchLeaderboardElementsById looks up the given IDs. IDs that are not in the leaderboard are omitted.
+func fetchLeaderboardElementsById(ctx context.Context, leaderboard momento.Leaderboard, ids []uint32) (map[string]types.Float64, error) {
+	elements := make(map[string]types.Float64)
+	for start := 0; start < len(ids); start += leaderboardBatchSize {
+		end := min(start+leaderboardBatchSize, len(ids))
+		resp, err := leaderboard.GetRank(ctx, momento.LeaderboardGetRankRequest{Ids: ids[start:end]})
+		if err != nil {
+			return nil, err
+		}
+		r, ok := resp.(*responses.LeaderboardFetchSuccess)
+		if !ok {
+			return nil, fmt.Errorf("unexpected response type %T", resp)
+		}
+		for _, element := range r.Values() {
+			elements[strconv.FormatUint(uint64(element.Id), 10)] = types.Float64Value(element.Score)
+		}
+	}
+	return elements, nil
+}
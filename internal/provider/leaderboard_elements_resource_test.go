@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestLeaderboardElementsResource(t *testing.T) {
+	cacheName := "terraform-provider-momento-test-" + acctest.RandString(8)
+	leaderboardName := "terraform-provider-momento-test-" + acctest.RandString(8)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		// Each TestStep represents one `terraform apply`
+		Steps: []resource.TestStep{
+			// Seed the leaderboard
+			{
+				Config: testAccLeaderboardElementsResourceConfig(cacheName, leaderboardName, `{ "1" = 10, "2" = 20.5 }`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("momento_leaderboard_elements.test", "Create"),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("momento_leaderboard_elements.test", "id", cacheName+"/"+leaderboardName),
+					resource.TestCheckResourceAttr("momento_leaderboard_elements.test", "elements.%", "2"),
+					resource.TestCheckResourceAttr("momento_leaderboard_elements.test", "elements.2", "20.5"),
+					resource.TestCheckResourceAttr("momento_leaderboard_elements.test", "ignore_unlisted_elements", "false"),
+				),
+			},
+			// Change a score, add an element and drop an element in place
+			{
+				Config: testAccLeaderboardElementsResourceConfig(cacheName, leaderboardName, `{ "1" = 15, "3" = 30 }`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("momento_leaderboard_elements.test", "Update"),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("momento_leaderboard_elements.test", "elements.%", "2"),
+					resource.TestCheckResourceAttr("momento_leaderboard_elements.test", "elements.1", "15"),
+					resource.TestCheckResourceAttr("momento_leaderboard_elements.test", "elements.3", "30"),
+				),
+			},
+			// Test ImportState method (imports existing resources)
+			{
+				ResourceName:      "momento_leaderboard_elements.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccLeaderboardElementsResourceConfig(cacheName string, leaderboardName string, elements string) string {
+	return testAccCacheResourceConfig(cacheName) + fmt.Sprintf(`
+resource "momento_leaderboard" "test" {
+	name = %[1]q
+	cache_name = momento_cache.test.name
+}
+
+resource "momento_leaderboard_elements" "test" {
+	cache_name = momento_leaderboard.test.cache_name
+	leaderboard_name = momento_leaderboard.test.name
+	elements = %[2]s
+}
+`, leaderboardName, elements)
+}
+
+func TestIsLeaderboardElementId(t *testing.T) {
+	tests := map[string]bool{
+		"0":          true,
+		"1":          true,
+		"4294967295": true,
+		"01":         false,
+		"00":         false,
+		"+1":         false,
+		"-1":         false,
+		" 1":         false,
+		"1.0":        false,
+		"4294967296": false,
+		"":           false,
+	}
+	for id, want := range tests {
+		if got := isLeaderboardElementId(id); got != want {
+			t.Errorf("isLeaderboardElementId(%q) = %v, want %v", id, got, want)
+		}
+	}
+}
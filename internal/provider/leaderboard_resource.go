@@ -2,20 +2,25 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/momentohq/client-sdk-go/momento"
+	"github.com/momentohq/client-sdk-go/responses"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource              = &LeaderboardResource{}
-	_ resource.ResourceWithConfigure = &LeaderboardResource{}
+	_ resource.Resource                = &LeaderboardResource{}
+	_ resource.ResourceWithConfigure   = &LeaderboardResource{}
+	_ resource.ResourceWithImportState = &LeaderboardResource{}
 )
 
 func NewLeaderboardResource() resource.Resource {
@@ -24,7 +29,8 @@ func NewLeaderboardResource() resource.Resource {
 
 // LeaderboardResource defines the resource implementation.
 type LeaderboardResource struct {
-	client *momento.PreviewLeaderboardClient
+	client      *momento.PreviewLeaderboardClient
+	cacheClient *momento.CacheClient
 }
 
 // LeaderboardResourceModel describes the resource data model.
@@ -32,6 +38,7 @@ type LeaderboardResourceModel struct {
 	Name      types.String `tfsdk:"name"`
 	CacheName types.String `tfsdk:"cache_name"`
 	Id        types.String `tfsdk:"id"`
+	Length    types.Int64  `tfsdk:"length"`
 }
 
 func (l *LeaderboardResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -59,12 +66,16 @@ func (l *LeaderboardResource) Schema(ctx context.Context, req resource.SchemaReq
 			},
 			// The testing framework requires an id attribute to be present in every data source and resource
 			"id": schema.StringAttribute{
-				Description: "The ID of the cache.",
+				Description: "The ID of the leaderboard, in the form `cache_name/name`.",
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"length": schema.Int64Attribute{
+				MarkdownDescription: "The number of elements in the leaderboard.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -87,8 +98,10 @@ func (l *LeaderboardResource) Configure(ctx context.Context, req resource.Config
 	}
 
 	client := clients.leaderboard
+	cacheClient := clients.cache
 
 	l.client = &client
+	l.cacheClient = &cacheClient
 }
 
 func (l *LeaderboardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -113,7 +126,8 @@ func (l *LeaderboardResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	// Map response body to schema and populate computed attribute values
-	plan.Id = types.StringValue(plan.Name.ValueString())
+	plan.Id = types.StringValue(leaderboardId(plan.CacheName.ValueString(), plan.Name.ValueString()))
+	plan.Length = types.Int64Value(0)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -129,9 +143,29 @@ func (l *LeaderboardResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
-	// Close the Leaderboard
+	// Delete the leaderboard. The client is shared with other resources, so it must not be closed here.
 	client := *l.client
-	client.Close()
+	leaderboard, err := client.Leaderboard(ctx, &momento.LeaderboardRequest{
+		LeaderboardName: state.Name.ValueString(),
+		CacheName:       state.CacheName.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete leaderboard, got error: %s", err))
+		return
+	}
+	deleteResp, err := leaderboard.Delete(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete leaderboard, got error: %s", err))
+		return
+	}
+
+	switch deleteResp.(type) {
+	case *responses.LeaderboardDeleteSuccess:
+		break
+	default:
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete leaderboard, got unknown response type: %T", deleteResp))
+		return
+	}
 }
 
 func (l *LeaderboardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -144,7 +178,30 @@ func (l *LeaderboardResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	state.Id = types.StringValue(state.Name.ValueString())
+	length, err := leaderboardLength(ctx, *l.client, state.CacheName.ValueString(), state.Name.ValueString())
+	if isMomentoNotFoundError(err) {
+		resp.Diagnostics.AddWarning("Leaderboard Not Found", fmt.Sprintf("Leaderboard \"%s\" in cache \"%s\" not found, removing from state", state.Name.ValueString(), state.CacheName.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		// Leaderboards exist implicitly within their cache, so a leaderboard is gone once its cache is.
+		found, findErr := findCache(ctx, *l.cacheClient, state.CacheName.ValueString())
+		if findErr != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list caches, got error: %s", findErr))
+			return
+		}
+		if !found {
+			resp.Diagnostics.AddWarning("Leaderboard Not Found", fmt.Sprintf("Cache with name \"%s\" containing leaderboard \"%s\" not found, removing from state", state.CacheName.ValueString(), state.Name.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read leaderboard length, got error: %s", err))
+		return
+	}
+
+	state.Id = types.StringValue(leaderboardId(state.CacheName.ValueString(), state.Name.ValueString()))
+	state.Length = types.Int64Value(int64(length))
 
 	// Set refreshed state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -166,3 +223,55 @@ func (l *LeaderboardResource) Update(ctx context.Context, req resource.UpdateReq
 
 	resp.Diagnostics.AddError("Internal Error", "Leaderboard resource does not support updates")
 }
+
+func (l *LeaderboardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	cacheName, leaderboardName, err := parseLeaderboardId(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cache_name"), cacheName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), leaderboardName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// leaderboardId returns the composite ID of a leaderboard, since leaderboard names are only unique within a cache.
+func leaderboardId(cacheName string, leaderboardName string) string {
+	return cacheName + "/" + leaderboardName
+}
+
+func parseLeaderboardId(id string) (string, string, error) {
+	cacheName, leaderboardName, ok := strings.Cut(id, "/")
+	if !ok || cacheName == "" || leaderboardName == "" {
+		return "", "", fmt.Errorf("expected import ID in the form cache_name/leaderboard_name, got: %q", id)
+	}
+	return cacheName, leaderboardName, nil
+}
+
+// isMomentoNotFoundError reports whether err is a Momento error for a missing cache or leaderboard.
+func isMomentoNotFoundError(err error) bool {
+	var momentoErr momento.MomentoError
+	if !errors.As(err, &momentoErr) {
+		return false
+	}
+	return momentoErr.Code() == momento.NotFoundError || momentoErr.Code() == momento.CacheNotFoundError
+}
+
+func leaderboardLength(ctx context.Context, client momento.PreviewLeaderboardClient, cacheName string, leaderboardName string) (uint32, error) {
+	leaderboard, err := client.Leaderboard(ctx, &momento.LeaderboardRequest{
+		LeaderboardName: leaderboardName,
+		CacheName:       cacheName,
+	})
+	if err != nil {
+		return 0, err
+	}
+	resp, err := leaderboard.Length(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if r, ok := resp.(*responses.LeaderboardLengthSuccess); ok {
+		return r.Length(), nil
+	}
+	return 0, fmt.Errorf("unexpected response type %T", resp)
+}
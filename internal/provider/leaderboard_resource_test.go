@@ -1,12 +1,18 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/momentohq/client-sdk-go/momento"
+	"github.com/momentohq/client-sdk-go/responses"
 )
 
 func TestCreateLeaderboardResource(t *testing.T) {
@@ -42,9 +48,16 @@ func TestCreateLeaderboardResource(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("momento_leaderboard.test", "name", leaderboardName1),
 					resource.TestCheckResourceAttr("momento_leaderboard.test", "cache_name", cacheName1),
-					resource.TestCheckResourceAttr("momento_leaderboard.test", "id", leaderboardName1),
+					resource.TestCheckResourceAttr("momento_leaderboard.test", "id", cacheName1+"/"+leaderboardName1),
+					resource.TestCheckResourceAttr("momento_leaderboard.test", "length", "0"),
 				),
 			},
+			// Test ImportState method (imports existing resources)
+			{
+				ResourceName:      "momento_leaderboard.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -58,3 +71,56 @@ resource "momento_leaderboard" "test" {
 }
 `, leaderboard_name, cache_name)
 }
+
+// testMomentoError is a Momento service error with the given code.
+type testMomentoError struct {
+	code string
+}
+
+func (e testMomentoError) Error() string      { return e.code }
+func (e testMomentoError) Code() string       { return e.code }
+func (e testMomentoError) Message() string    { return e.code }
+func (e testMomentoError) OriginalErr() error { return nil }
+
+// testLeaderboardClient returns leaderboards whose Length fails with lengthErr. Calls to other methods panic.
+type testLeaderboardClient struct {
+	momento.PreviewLeaderboardClient
+	lengthErr error
+}
+
+func (c testLeaderboardClient) Leaderboard(ctx context.Context, r *momento.LeaderboardRequest) (momento.Leaderboard, error) {
+	return testLeaderboard{lengthErr: c.lengthErr}, nil
+}
+
+type testLeaderboard struct {
+	momento.Leaderboard
+	lengthErr error
+}
+
+func (l testLeaderboard) Length(ctx context.Context) (responses.LeaderboardLengthResponse, error) {
+	return nil, l.lengthErr
+}
+
+func TestLeaderboardResourceReadRemovesDeletedLeaderboard(t *testing.T) {
+	ctx := context.Background()
+	var client momento.PreviewLeaderboardClient = testLeaderboardClient{lengthErr: testMomentoError{code: momento.NotFoundError}}
+	r := &LeaderboardResource{client: &client}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	schemaType := schemaResp.Schema.Type().TerraformType(ctx)
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+		"name":       tftypes.NewValue(tftypes.String, "leaderboard"),
+		"cache_name": tftypes.NewValue(tftypes.String, "cache"),
+		"id":         tftypes.NewValue(tftypes.String, "cache/leaderboard"),
+		"length":     tftypes.NewValue(tftypes.Number, 3),
+	})}
+
+	resp := &fwresource.ReadResponse{State: state}
+	r.Read(ctx, fwresource.ReadRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Errorf("state = %v, want the leaderboard removed", resp.State.Raw)
+	}
+}
@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+const (
+	keyTypeDisposableToken = "disposable_token"
+	keyTypeLegacyApiKey    = "legacy_api_key"
+	keyTypeV2ApiKey        = "v2_api_key"
+
+	// disposableTokenType is the token type claim of disposable tokens.
+	disposableTokenType = "d"
+)
+
+// momentoApiKeyInfo is the information decoded from a Momento credential.
+type momentoApiKeyInfo struct {
+	KeyType     string
+	Endpoint    string
+	ExpiresAt   *time.Time
+	Permissions json.RawMessage
+}
+
+type momentoApiKeyClaims struct {
+	TokenType       string          `json:"t"`
+	CacheEndpoint   string          `json:"c"`
+	ControlEndpoint string          `json:"cp"`
+	Expiry          *int64          `json:"exp"`
+	Permissions     json.RawMessage `json:"p"`
+}
+
+// decodeMomentoApiKey decodes a disposable token, legacy API key or V2 API key without verifying
+// its signature. The endpoint is only used for V2 API keys, which do not embed one.
+func decodeMomentoApiKey(apiKey string, endpoint string) (*momentoApiKeyInfo, error) {
+	// Disposable tokens and v1 API keys are base64 encoded JSON wrapping the endpoint and a JWT,
+	// and only the token type claim of the JWT tells them apart.
+	if decoded, err := base64.StdEncoding.DecodeString(apiKey); err == nil {
+		var token struct {
+			Endpoint string `json:"endpoint"`
+			ApiKey   string `json:"api_key"`
+		}
+		if err := json.Unmarshal(decoded, &token); err == nil && token.ApiKey != "" {
+			claims, err := decodeJwtClaims(token.ApiKey)
+			if err != nil {
+				return nil, err
+			}
+			if claims.TokenType == disposableTokenType {
+				return newMomentoApiKeyInfo(keyTypeDisposableToken, token.Endpoint, claims), nil
+			}
+			return newMomentoApiKeyInfo(keyTypeLegacyApiKey, token.Endpoint, claims), nil
+		}
+	}
+
+	claims, err := decodeJwtClaims(apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// Legacy API keys issued before v1 API keys embed their cache and control endpoints as claims.
+	if claims.CacheEndpoint != "" || claims.ControlEndpoint != "" {
+		legacyEndpoint := claims.CacheEndpoint
+		if legacyEndpoint == "" {
+			legacyEndpoint = claims.ControlEndpoint
+		}
+		return newMomentoApiKeyInfo(keyTypeLegacyApiKey, legacyEndpoint, claims), nil
+	}
+
+	return newMomentoApiKeyInfo(keyTypeV2ApiKey, endpoint, claims), nil
+}
+
+func newMomentoApiKeyInfo(keyType string, endpoint string, claims *momentoApiKeyClaims) *momentoApiKeyInfo {
+	info := &momentoApiKeyInfo{
+		KeyType:     keyType,
+		Endpoint:    normalizeMomentoEndpoint(endpoint),
+		Permissions: claims.Permissions,
+	}
+	if claims.Expiry != nil {
+		expiresAt := time.Unix(*claims.Expiry, 0)
+		info.ExpiresAt = &expiresAt
+	}
+	return info
+}
+
+func decodeJwtClaims(jwt string) (*momentoApiKeyClaims, error) {
+	parts := strings.Split(jwt, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("expected a JWT with 3 segments, got %d", len(parts))
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil, fmt.Errorf("error decoding JWT payload: %v", err)
+	}
+	var claims momentoApiKeyClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, fmt.Errorf("error unmarshalling JWT claims: %v", err)
+	}
+	return &claims, nil
+}
+
+// normalizeMomentoEndpoint strips service prefixes and the port so that endpoints taken from
+// keys and from the provider configuration can be compared.
+func normalizeMomentoEndpoint(endpoint string) string {
+	endpoint, _ = strings.CutSuffix(endpoint, ":443")
+	for _, prefix := range []string{"api.cache.", "cache.", "control.", "storage."} {
+		if withoutPrefix, ok := strings.CutPrefix(endpoint, prefix); ok {
+			return withoutPrefix
+		}
+	}
+	return endpoint
+}
+
+// cellFromEndpoint returns the cell name, which is the first label of the endpoint hostname.
+func cellFromEndpoint(endpoint string) string {
+	cell, _, _ := strings.Cut(normalizeMomentoEndpoint(endpoint), ".")
+	return cell
+}
+
+// v2ApiEndpointCellMismatch returns a warning when the key configured as a V2 API key is bound to a
+// different cell than the V2 API endpoint. V2 API keys issued by Momento carry no endpoint or cell
+// claim, so only keys that embed their own endpoint, e.g. a disposable token or legacy API key
+// passed as a V2 API key, can be checked. Keys that cannot be decoded are left to the service.
+func v2ApiEndpointCellMismatch(v2ApiKey string, endpoint string) *AttributeError {
+	keyInfo, err := decodeMomentoApiKey(v2ApiKey, "")
+	if err != nil || keyInfo.Endpoint == "" {
+		return nil
+	}
+	keyCell := cellFromEndpoint(keyInfo.Endpoint)
+	endpointCell := cellFromEndpoint(endpoint)
+	if keyCell == endpointCell {
+		return nil
+	}
+	return &AttributeError{
+		AttributePath: path.Root("v2_api_endpoint"),
+		Summary:       "Mismatched Momento API key and endpoint cells",
+		Detail: fmt.Sprintf("The configured %s is bound to cell %q but the V2 API endpoint points at cell %q. "+
+			"Requests will likely fail to authenticate. Check that the v2_api_endpoint (or MOMENTO_ENDPOINT) matches the cell the key was created in.",
+			keyInfo.KeyType, keyCell, endpointCell),
+	}
+}
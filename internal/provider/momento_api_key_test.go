@@ -0,0 +1,191 @@
+package provider
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func testJwt(t *testing.T, claims map[string]interface{}) string {
+	t.Helper()
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS512"}`))
+	return header + "." + base64.RawURLEncoding.EncodeToString(payload) + ".signature"
+}
+
+func testDisposableToken(t *testing.T, endpoint string, jwt string) string {
+	t.Helper()
+	token, err := json.Marshal(map[string]string{"endpoint": endpoint, "api_key": jwt})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return base64.StdEncoding.EncodeToString(token)
+}
+
+func TestDecodeMomentoApiKey(t *testing.T) {
+	disposableJwt := testJwt(t, map[string]interface{}{"t": "d", "exp": 1700000000, "p": map[string]interface{}{"permissions": []interface{}{}}})
+	tests := []struct {
+		name          string
+		apiKey        string
+		endpoint      string
+		wantKeyType   string
+		wantEndpoint  string
+		wantExpiresAt int64
+		wantErr       bool
+	}{
+		{
+			name:          "disposable token",
+			apiKey:        testDisposableToken(t, "cell-4-us-west-2-1.prod.a.momentohq.com", disposableJwt),
+			endpoint:      "cell-1-ap-southeast-1-1.prod.a.momentohq.com",
+			wantKeyType:   keyTypeDisposableToken,
+			wantEndpoint:  "cell-4-us-west-2-1.prod.a.momentohq.com",
+			wantExpiresAt: 1700000000,
+		},
+		{
+			name:         "v1 api key",
+			apiKey:       testDisposableToken(t, "cell-4-us-west-2-1.prod.a.momentohq.com", testJwt(t, map[string]interface{}{"sub": "user", "ver": 1})),
+			endpoint:     "cell-1-ap-southeast-1-1.prod.a.momentohq.com",
+			wantKeyType:  keyTypeLegacyApiKey,
+			wantEndpoint: "cell-4-us-west-2-1.prod.a.momentohq.com",
+		},
+		{
+			name:         "legacy api key with cache endpoint",
+			apiKey:       testJwt(t, map[string]interface{}{"c": "cache.cell-4-us-west-2-1.prod.a.momentohq.com", "cp": "control.cell-4-us-west-2-1.prod.a.momentohq.com"}),
+			wantKeyType:  keyTypeLegacyApiKey,
+			wantEndpoint: "cell-4-us-west-2-1.prod.a.momentohq.com",
+		},
+		{
+			name:         "legacy api key with control endpoint only",
+			apiKey:       testJwt(t, map[string]interface{}{"cp": "control.cell-us-east-1-1.prod.a.momentohq.com:443"}),
+			wantKeyType:  keyTypeLegacyApiKey,
+			wantEndpoint: "cell-us-east-1-1.prod.a.momentohq.com",
+		},
+		{
+			name:         "v2 api key uses the given endpoint",
+			apiKey:       testJwt(t, map[string]interface{}{"t": "g"}),
+			endpoint:     "api.cache.cell-1-ap-southeast-1-1.prod.a.momentohq.com",
+			wantKeyType:  keyTypeV2ApiKey,
+			wantEndpoint: "cell-1-ap-southeast-1-1.prod.a.momentohq.com",
+		},
+		{
+			name:        "v2 api key without endpoint",
+			apiKey:      testJwt(t, map[string]interface{}{"t": "g"}),
+			wantKeyType: keyTypeV2ApiKey,
+		},
+		{
+			name:    "not a jwt",
+			apiKey:  "not-a-key",
+			wantErr: true,
+		},
+		{
+			name:    "invalid payload",
+			apiKey:  "header.!!!.signature",
+			wantErr: true,
+		},
+		{
+			name:    "disposable token wrapping an invalid jwt",
+			apiKey:  testDisposableToken(t, "cell-4-us-west-2-1.prod.a.momentohq.com", "not-a-jwt"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, err := decodeMomentoApiKey(tt.apiKey, tt.endpoint)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %+v", info)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if info.KeyType != tt.wantKeyType {
+				t.Errorf("KeyType = %q, want %q", info.KeyType, tt.wantKeyType)
+			}
+			if info.Endpoint != tt.wantEndpoint {
+				t.Errorf("Endpoint = %q, want %q", info.Endpoint, tt.wantEndpoint)
+			}
+			switch {
+			case tt.wantExpiresAt == 0 && info.ExpiresAt != nil:
+				t.Errorf("ExpiresAt = %v, want nil", info.ExpiresAt)
+			case tt.wantExpiresAt != 0 && (info.ExpiresAt == nil || info.ExpiresAt.Unix() != tt.wantExpiresAt):
+				t.Errorf("ExpiresAt = %v, want %d", info.ExpiresAt, tt.wantExpiresAt)
+			}
+		})
+	}
+}
+
+func TestNormalizeMomentoEndpoint(t *testing.T) {
+	tests := map[string]string{
+		"cell-1-ap-southeast-1-1.prod.a.momentohq.com":          "cell-1-ap-southeast-1-1.prod.a.momentohq.com",
+		"cache.cell-4-us-west-2-1.prod.a.momentohq.com":         "cell-4-us-west-2-1.prod.a.momentohq.com",
+		"control.cell-4-us-west-2-1.prod.a.momentohq.com":       "cell-4-us-west-2-1.prod.a.momentohq.com",
+		"storage.cell-4-us-west-2-1.prod.a.momentohq.com":       "cell-4-us-west-2-1.prod.a.momentohq.com",
+		"api.cache.cell-4-us-west-2-1.prod.a.momentohq.com:443": "cell-4-us-west-2-1.prod.a.momentohq.com",
+		"cell-4-us-west-2-1.prod.a.momentohq.com:8443":          "cell-4-us-west-2-1.prod.a.momentohq.com:8443",
+		"": "",
+	}
+	for endpoint, want := range tests {
+		if got := normalizeMomentoEndpoint(endpoint); got != want {
+			t.Errorf("normalizeMomentoEndpoint(%q) = %q, want %q", endpoint, got, want)
+		}
+	}
+}
+
+func TestCellFromEndpoint(t *testing.T) {
+	tests := map[string]string{
+		"cell-1-ap-southeast-1-1.prod.a.momentohq.com":      "cell-1-ap-southeast-1-1",
+		"cache.cell-4-us-west-2-1.prod.a.momentohq.com:443": "cell-4-us-west-2-1",
+		"api.cache.cell-us-east-1-1.prod.a.momentohq.com":   "cell-us-east-1-1",
+		"localhost": "localhost",
+		"":          "",
+	}
+	for endpoint, want := range tests {
+		if got := cellFromEndpoint(endpoint); got != want {
+			t.Errorf("cellFromEndpoint(%q) = %q, want %q", endpoint, got, want)
+		}
+	}
+}
+
+func TestV2ApiEndpointCellMismatch(t *testing.T) {
+	endpoint := "cell-4-us-west-2-1.prod.a.momentohq.com"
+	disposableJwt := testJwt(t, map[string]interface{}{"t": "d"})
+	tests := []struct {
+		name        string
+		apiKey      string
+		wantWarning string
+	}{
+		{name: "disposable token in another cell", apiKey: testDisposableToken(t, "cell-1-ap-southeast-1-1.prod.a.momentohq.com", disposableJwt), wantWarning: `disposable_token is bound to cell "cell-1-ap-southeast-1-1"`},
+		{name: "legacy api key in another cell", apiKey: testJwt(t, map[string]interface{}{"c": "cache.cell-us-east-1-1.prod.a.momentohq.com"}), wantWarning: `legacy_api_key is bound to cell "cell-us-east-1-1"`},
+		{name: "disposable token in the same cell", apiKey: testDisposableToken(t, "cache."+endpoint, disposableJwt)},
+		{name: "v2 api key", apiKey: testJwt(t, map[string]interface{}{"t": "g"})},
+		{name: "undecodable key", apiKey: "not-a-key"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			warning := v2ApiEndpointCellMismatch(tt.apiKey, endpoint)
+			if tt.wantWarning == "" {
+				if warning != nil {
+					t.Errorf("unexpected warning: %s", warning.Detail)
+				}
+				return
+			}
+			if warning == nil {
+				t.Fatal("expected a warning")
+			}
+			if !warning.AttributePath.Equal(path.Root("v2_api_endpoint")) {
+				t.Errorf("warning on %s, want v2_api_endpoint", warning.AttributePath)
+			}
+			if !strings.Contains(warning.Detail, tt.wantWarning) || !strings.Contains(warning.Detail, `"cell-4-us-west-2-1"`) {
+				t.Errorf("warning detail %q does not report the key and endpoint cells", warning.Detail)
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -18,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -36,6 +38,7 @@ type ObjectStoreResource struct {
 	httpClient    *http.Client
 	httpEndpoint  string
 	httpAuthToken string
+	defaultTags   map[string]string
 }
 
 type AccessLoggingConfig struct {
@@ -76,6 +79,9 @@ type ObjectStoreResourceModel struct {
 	ThrottlingLimits          *ThrottlingLimitsConfig `tfsdk:"throttling_limits"`
 	PerRouterThrottlingLimits types.Object            `tfsdk:"per_router_throttling_limits"`
 	RouterCount               types.Int64             `tfsdk:"router_count"`
+	Tags                      types.Map               `tfsdk:"tags"`
+	TagsAll                   types.Map               `tfsdk:"tags_all"`
+	Timeouts                  timeouts.Value          `tfsdk:"timeouts"`
 }
 
 func (r *ObjectStoreResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -124,11 +130,10 @@ func (r *ObjectStoreResource) Schema(ctx context.Context, req resource.SchemaReq
 				},
 			},
 			"valkey_cluster_name": schema.StringAttribute{
-				MarkdownDescription: "The name of the Momento Valkey Cluster to use for automatic caching.",
-				Required:            true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				MarkdownDescription: "The name of the Momento Valkey Cluster to use for automatic caching. Changing the cluster repoints the object store in place once the new cluster is active, waiting for it up to the update timeout, 60 minutes by default. " +
+					"Moving to a new cluster that replaces the current one requires `lifecycle { create_before_destroy = true }` on the `momento_valkey_cluster` resource, and a new cluster name so that both clusters exist during the cut-over. " +
+					"Without it Terraform destroys the old cluster before the object store is repointed, which fails while the object store uses it, or deletes the object store along with it when the cluster sets `force_destroy`.",
+				Required: true,
 			},
 			"access_logging_config": schema.SingleNestedAttribute{
 				MarkdownDescription: "Optional configuration for access logging through CloudWatch.",
@@ -215,6 +220,21 @@ func (r *ObjectStoreResource) Schema(ctx context.Context, req resource.SchemaReq
 				MarkdownDescription: "The number of Momento router nodes backing this object store, computed from the /endpoints API.",
 				Computed:            true,
 			},
+			"tags": schema.MapAttribute{
+				MarkdownDescription: "Tags to assign to the Object Store. Tags override provider `default_tags` with the same key. Changing the tags updates the Object Store in place.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"tags_all": schema.MapAttribute{
+				MarkdownDescription: "All tags of the Object Store, including those inherited from the provider `default_tags`.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Update: true,
+			}),
 		},
 	}
 }
@@ -239,6 +259,7 @@ func (r *ObjectStoreResource) Configure(ctx context.Context, req resource.Config
 	r.httpClient = clients.httpClient
 	r.httpEndpoint = clients.httpEndpoint
 	r.httpAuthToken = clients.httpAuthToken
+	r.defaultTags = clients.defaultTags
 }
 
 type AttributeError struct {
@@ -423,6 +444,7 @@ func marshalObjectStoreRequest(plan *ObjectStoreResourceModel, perRouterLimits *
 				ClusterName: plan.ValkeyClusterName.ValueString(),
 			},
 		},
+		Tags: tagsFromMap(plan.TagsAll),
 	}
 	if plan.AccessLoggingConfig != nil {
 		requestData.AccessLoggingConfig = &ObjectStoreAccessLoggingConfig{
@@ -496,6 +518,10 @@ func sendObjectStoreRequest(plan *ObjectStoreResourceModel, r *ObjectStoreResour
 // Will detect if router count has changed and produce a diff so that next terraform apply
 // will update the object store with new per-router throttling limits.
 func (r *ObjectStoreResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.Plan.Raw.IsNull() {
+		resp.Diagnostics.Append(planTagsAll(ctx, r.defaultTags, &resp.Plan)...)
+	}
+
 	// Only run during updates: skip Create (state null), Delete (plan null), or unconfigured provider.
 	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || r.httpClient == nil {
 		return
@@ -508,7 +534,7 @@ func (r *ObjectStoreResource) ModifyPlan(ctx context.Context, req resource.Modif
 	}
 
 	var plan ObjectStoreResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -579,6 +605,12 @@ func (r *ObjectStoreResource) Create(ctx context.Context, req resource.CreateReq
 		plan.RouterCount = types.Int64Null()
 	}
 	plan.PerRouterThrottlingLimits = perRouterLimitsObj
+	tagsAllValue, diags := tagsAll(ctx, r.defaultTags, plan.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.TagsAll = tagsAllValue
 
 	// Create and allow retrying up to 3 times in case of eventual consistency issues
 	// with the Valkey Cluster or IAM roles coming online.
@@ -606,27 +638,30 @@ func (r *ObjectStoreResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
-	client := *r.httpClient
-	deleteUrl := fmt.Sprintf("%s/objectstore/%s", r.httpEndpoint, state.Name.ValueString())
-	deleteRequest, err := http.NewRequest("DELETE", deleteUrl, nil)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create HTTP request to delete object store, got error: %s", err))
+	if err := deleteObjectStore(*r.httpClient, state.Name.ValueString(), r.httpEndpoint, r.httpAuthToken); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete object store, got error: %s", err))
 		return
 	}
-	deleteRequest.Header.Set("Authorization", r.httpAuthToken)
+}
 
-	httpResp, err := client.Do(deleteRequest)
+// DELETE /objectstore/<name>
+// Expected response: 2xx.
+func deleteObjectStore(client http.Client, name string, httpEndpoint string, httpAuthToken string) error {
+	deleteRequest, err := http.NewRequest("DELETE", fmt.Sprintf("%s/objectstore/%s", httpEndpoint, name), nil)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete object store, got error: %s", err))
-		return
+		return err
 	}
-	if httpResp != nil && httpResp.Body != nil {
-		_ = httpResp.Body.Close()
+	deleteRequest.Header.Set("Authorization", httpAuthToken)
+
+	httpResp, err := client.Do(deleteRequest)
+	if err != nil {
+		return err
 	}
+	defer func() { _ = httpResp.Body.Close() }()
 	if httpResp.StatusCode >= 300 {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete object store, got non-200 response: %d", httpResp.StatusCode))
-		return
+		return fmt.Errorf("got non-200 response: %d", httpResp.StatusCode)
 	}
+	return nil
 }
 
 func (r *ObjectStoreResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -673,6 +708,7 @@ func (r *ObjectStoreResource) Read(ctx context.Context, req resource.ReadRequest
 			Region:     types.StringValue(foundObjectStore.MetricsConfig.Cloudwatch.Region),
 		}
 	}
+	resp.Diagnostics.Append(refreshTags(ctx, foundObjectStore.Tags, r.defaultTags, &state.Tags, &state.TagsAll)...)
 	// throttling_limits is intentionally not updated from the API response. The API stores
 	// per-router limits (what was sent on the last apply), not the user-supplied aggregate limits.
 	// Since the aggregate→per-router division uses ceiling arithmetic, the original values cannot
@@ -707,6 +743,27 @@ func (r *ObjectStoreResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	var state ObjectStoreResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The object store is only repointed to an active cluster, so that the old cluster keeps serving it until then
+	if plan.ValkeyClusterName.ValueString() != state.ValkeyClusterName.ValueString() {
+		updateTimeout, diags := plan.Timeouts.Update(ctx, objectStoreCutOverTimeout)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if err := r.waitUntilCutOverClusterActive(ctx, plan.ValkeyClusterName.ValueString(), updateTimeout); err != nil {
+			resp.Diagnostics.AddError("Valkey Cluster Not Ready",
+				fmt.Sprintf("The object store still uses cluster %q, since cluster %q is not ready: %s", state.ValkeyClusterName.ValueString(), plan.ValkeyClusterName.ValueString(), err))
+			return
+		}
+		tflog.Info(ctx, "Repointing object store to valkey cluster", map[string]interface{}{"name": plan.Name.ValueString(), "from": state.ValkeyClusterName.ValueString(), "to": plan.ValkeyClusterName.ValueString()})
+	}
+
 	routerCount, err := fetchRouterCount(*r.httpClient, r.httpEndpoint, r.httpAuthToken)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch router node count: %s", err))
@@ -723,6 +780,12 @@ func (r *ObjectStoreResource) Update(ctx context.Context, req resource.UpdateReq
 		plan.RouterCount = types.Int64Null()
 	}
 	plan.PerRouterThrottlingLimits = perRouterLimitsObj
+	tagsAllValue, diags := tagsAll(ctx, r.defaultTags, plan.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.TagsAll = tagsAllValue
 
 	// Update and allow retrying up to 3 times in case of transient errors.
 	if err = r.applyObjectStoreWithRetry(ctx, &plan, perRouterLimits); err != nil {
@@ -739,6 +802,31 @@ func (r *ObjectStoreResource) Update(ctx context.Context, req resource.UpdateReq
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
+// objectStoreCutOverTimeout is the default bound on the wait for the cluster an object store is moved to, which may
+// still be being created when it was created without waiting for it.
+const objectStoreCutOverTimeout = 60 * time.Minute
+
+// waitUntilCutOverClusterActive returns once the cluster is active. A cluster that is already active is not polled,
+// so that the update is not delayed by a poll interval.
+func (r *ObjectStoreResource) waitUntilCutOverClusterActive(ctx context.Context, clusterName string, timeout time.Duration) error {
+	cluster, err := describeValkeyCluster(*r.httpClient, clusterName, r.httpEndpoint, r.httpAuthToken)
+	if err != nil {
+		return err
+	}
+	if cluster == nil || (cluster.Status != "Active" && cluster.Status != "CreationFailed") {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		cluster, err = waitUntilValkeyClusterActive(ctx, *r.httpClient, clusterName, r.httpEndpoint, r.httpAuthToken, defaultPollInterval)
+		if err != nil {
+			return err
+		}
+	}
+	if cluster.Status != "Active" {
+		return fmt.Errorf("cluster status is %s", cluster.Status)
+	}
+	return nil
+}
+
 func (r *ObjectStoreResource) applyObjectStoreWithRetry(ctx context.Context, plan *ObjectStoreResourceModel, perRouterLimits *ThrottlingLimitsConfig) error {
 	ticker := time.NewTicker(10 * time.Second)
 	defer ticker.Stop()
@@ -811,6 +899,7 @@ type ObjectStoreData struct {
 	AccessLoggingConfig *ObjectStoreAccessLoggingConfig `json:"access_logging_config,omitempty"`
 	MetricsConfig       *ObjectStoreMetricsConfig       `json:"metrics_config,omitempty"`
 	ThrottlingLimits    *ObjectStoreThrottlingLimits    `json:"object_store_limits,omitempty"`
+	Tags                map[string]string               `json:"tags"`
 }
 
 func fetchRouterCount(client http.Client, httpEndpoint string, httpAuthToken string) (int64, error) {
@@ -877,3 +966,34 @@ func describeObjectStore(client http.Client, name string, httpEndpoint string, h
 	}
 	return &objectStore, nil
 }
+
+// GET /objectstore
+// Expected response: a JSON array of object stores in the format of the describe response.
+func listObjectStores(client http.Client, httpEndpoint string, httpAuthToken string) ([]ObjectStoreData, error) {
+	getRequest, err := http.NewRequest("GET", fmt.Sprintf("%s/objectstore", httpEndpoint), nil)
+	if err != nil {
+		return nil, err
+	}
+	getRequest.Header.Set("Authorization", httpAuthToken)
+	getResp, err := client.Do(getRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = getResp.Body.Close() }()
+	if getResp.StatusCode >= 300 {
+		body, _ := io.ReadAll(getResp.Body)
+		return nil, fmt.Errorf("unable to list object stores, got non-2xx response: %s %s", getResp.Status, string(body))
+	}
+
+	bodyBytes, err := io.ReadAll(getResp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %v", err)
+	}
+
+	var objectStores []ObjectStoreData
+	err = json.Unmarshal(bodyBytes, &objectStores)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling JSON: %v", err)
+	}
+	return objectStores, nil
+}
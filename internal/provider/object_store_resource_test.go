@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testObjectStoreApi serves the cluster, endpoints and object store APIs used when updating an object store, and
+// records the clusters object stores are pointed at.
+type testObjectStoreApi struct {
+	clusterStatus map[string]string
+	requests      []string
+	pointedAt     []string
+}
+
+func (a *testObjectStoreApi) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	a.requests = append(a.requests, req.Method+" "+req.URL.Path)
+	clusterName, isCluster := strings.CutPrefix(req.URL.Path, "/ec-cluster/")
+	switch {
+	case req.Method == "GET" && req.URL.Path == "/endpoints":
+		_, _ = io.WriteString(w, `{"usw2-az1": [{"socket_address": "10.0.0.1:9000"}]}`)
+	case req.Method == "GET" && isCluster:
+		status, ok := a.clusterStatus[clusterName]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{"name": clusterName, "status": status})
+	case req.Method == "PUT" && req.URL.Path == "/objectstore/store":
+		var objectStore ObjectStoreData
+		if err := json.NewDecoder(req.Body).Decode(&objectStore); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		a.pointedAt = append(a.pointedAt, objectStore.CacheConfig.ValkeyCluster.ClusterName)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func testObjectStoreValue(t *testing.T, schemaType tftypes.Object, clusterName string) tftypes.Value {
+	t.Helper()
+	values := make(map[string]tftypes.Value, len(schemaType.AttributeTypes))
+	for name, attributeType := range schemaType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	values["id"] = tftypes.NewValue(tftypes.String, "store")
+	values["name"] = tftypes.NewValue(tftypes.String, "store")
+	values["s3_bucket_name"] = tftypes.NewValue(tftypes.String, "bucket")
+	values["s3_iam_role_arn"] = tftypes.NewValue(tftypes.String, "arn:aws:iam::123456789012:role/store")
+	values["valkey_cluster_name"] = tftypes.NewValue(tftypes.String, clusterName)
+	return tftypes.NewValue(schemaType, values)
+}
+
+func testUpdateObjectStore(t *testing.T, api *testObjectStoreApi, fromCluster string, toCluster string) *resource.UpdateResponse {
+	t.Helper()
+	ctx := context.Background()
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+
+	r := &ObjectStoreResource{httpClient: server.Client(), httpEndpoint: server.URL}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	schemaType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: testObjectStoreValue(t, schemaType, toCluster)}
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: testObjectStoreValue(t, schemaType, fromCluster)}
+
+	resp := &resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+	return resp
+}
+
+func TestObjectStoreResourceUpdateRepointsToActiveCluster(t *testing.T) {
+	api := &testObjectStoreApi{clusterStatus: map[string]string{"blue": "Active", "green": "Active"}}
+	resp := testUpdateObjectStore(t, api, "blue", "green")
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if len(api.pointedAt) != 1 || api.pointedAt[0] != "green" {
+		t.Errorf("object store pointed at %v, want [green]", api.pointedAt)
+	}
+	if len(api.requests) == 0 || api.requests[0] != "GET /ec-cluster/green" {
+		t.Errorf("requests = %v, want the new cluster described first", api.requests)
+	}
+	var updated ObjectStoreResourceModel
+	if diags := resp.State.Get(context.Background(), &updated); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if got := updated.ValkeyClusterName.ValueString(); got != "green" {
+		t.Errorf("valkey_cluster_name = %q, want green", got)
+	}
+}
+
+func TestObjectStoreResourceUpdateKeepsClusterThatFailed(t *testing.T) {
+	api := &testObjectStoreApi{clusterStatus: map[string]string{"blue": "Active", "green": "CreationFailed"}}
+	resp := testUpdateObjectStore(t, api, "blue", "green")
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error")
+	}
+	if len(api.pointedAt) != 0 {
+		t.Errorf("object store pointed at %v, want it left on blue", api.pointedAt)
+	}
+}
+
+func TestObjectStoreResourceUpdateWithoutCutOver(t *testing.T) {
+	api := &testObjectStoreApi{clusterStatus: map[string]string{}}
+	resp := testUpdateObjectStore(t, api, "blue", "blue")
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	for _, request := range api.requests {
+		if request == "GET /ec-cluster/blue" {
+			t.Errorf("requests = %v, want the cluster not described when it is unchanged", api.requests)
+		}
+	}
+	if len(api.pointedAt) != 1 || api.pointedAt[0] != "blue" {
+		t.Errorf("object store pointed at %v, want [blue]", api.pointedAt)
+	}
+}
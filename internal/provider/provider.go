@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/momentohq/client-sdk-go/auth"
 	"github.com/momentohq/client-sdk-go/config"
 	"github.com/momentohq/client-sdk-go/momento"
@@ -31,17 +32,26 @@ type MomentoProvider struct {
 
 // MomentoProviderModel describes the provider data model.
 type MomentoProviderModel struct {
-	AuthToken types.String `tfsdk:"api_key"`
-	V2ApiKey  types.String `tfsdk:"v2_api_key"`
-	Endpoint  types.String `tfsdk:"v2_api_endpoint"`
+	AuthToken   types.String      `tfsdk:"api_key"`
+	V2ApiKey    types.String      `tfsdk:"v2_api_key"`
+	Endpoint    types.String      `tfsdk:"v2_api_endpoint"`
+	DefaultTags *DefaultTagsModel `tfsdk:"default_tags"`
+}
+
+type DefaultTagsModel struct {
+	Tags types.Map `tfsdk:"tags"`
 }
 
 type MomentoClients struct {
 	cache         momento.CacheClient
 	leaderboard   momento.PreviewLeaderboardClient
+	storage       momento.PreviewStorageClient
 	httpClient    *http.Client
 	httpEndpoint  string
 	httpAuthToken string
+	apiKey        string
+	v2Endpoint    string
+	defaultTags   map[string]string
 }
 
 func (p *MomentoProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -61,8 +71,21 @@ func (p *MomentoProvider) Schema(ctx context.Context, req provider.SchemaRequest
 				Optional:            true,
 			},
 			"v2_api_endpoint": schema.StringAttribute{
-				MarkdownDescription: "Momento API Endpoint. May also be provided via MOMENTO_ENDPOINT environment variable alongside the MOMENTO_API_KEY environment variable containing a V2 API key.",
-				Optional:            true,
+				MarkdownDescription: "Momento API Endpoint. May also be provided via MOMENTO_ENDPOINT environment variable alongside the MOMENTO_API_KEY environment variable containing a V2 API key. " +
+					"A warning is reported if the key embeds an endpoint in a different cell; V2 API keys issued by Momento do not embed one, so their cell cannot be checked.",
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"default_tags": schema.SingleNestedBlock{
+				MarkdownDescription: "Tags to assign to every resource that supports tags. Tags configured on a resource override default tags with the same key.",
+				Attributes: map[string]schema.Attribute{
+					"tags": schema.MapAttribute{
+						MarkdownDescription: "The default tags.",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+				},
 			},
 		},
 	}
@@ -104,12 +127,26 @@ func (p *MomentoProvider) Configure(ctx context.Context, req provider.ConfigureR
 		)
 	}
 
+	if model.DefaultTags != nil && model.DefaultTags.Tags.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("default_tags").AtName("tags"),
+			"Unknown Momento default tags value",
+			"The provider cannot tag resources as there is an unknown configuration value for the default tags. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
 	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	var defaultTags map[string]string
+	if model.DefaultTags != nil {
+		defaultTags = tagsFromMap(model.DefaultTags.Tags)
+	}
+
 	// Default values to environment variables, but override
 	// with Terraform configuration value if set.
 
@@ -146,9 +183,13 @@ func (p *MomentoProvider) Configure(ctx context.Context, req provider.ConfigureR
 	var credProvider auth.CredentialProvider
 	var credError error
 	var httpAuthToken string
+	var apiKey string
+	var v2Endpoint string
 
 	if endpoint != "" && v2ApiKey != "" {
 		httpAuthToken = v2ApiKey
+		apiKey = v2ApiKey
+		v2Endpoint = endpoint
 		credProvider, credError = auth.FromApiKeyV2(auth.ApiKeyV2Props{ApiKey: v2ApiKey, Endpoint: endpoint})
 		if credError != nil {
 			resp.Diagnostics.AddError(
@@ -159,7 +200,13 @@ func (p *MomentoProvider) Configure(ctx context.Context, req provider.ConfigureR
 			)
 			return
 		}
+
+		if warning := v2ApiEndpointCellMismatch(v2ApiKey, endpoint); warning != nil {
+			tflog.Warn(ctx, warning.Summary, map[string]interface{}{"detail": warning.Detail})
+			resp.Diagnostics.AddAttributeWarning(warning.AttributePath, warning.Summary, warning.Detail)
+		}
 	} else {
+		apiKey = authToken
 		credProvider, credError = auth.FromDisposableToken(authToken)
 		if credError != nil {
 			resp.Diagnostics.AddError(
@@ -203,6 +250,17 @@ func (p *MomentoProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
+	storageClient, err := momento.NewPreviewStorageClient(config.StorageLaptopLatest(), credProvider)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Momento Storage Client",
+			"An unexpected error occurred when creating the Momento API client. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"Momento Client Error: "+err.Error(),
+		)
+		return
+	}
+
 	// Create an HTTP client for resources that use Momento HTTP APIs
 	httpClient := &http.Client{}
 	httpEndpoint := fmt.Sprintf("https://api.cache.%s", endpoint)
@@ -212,16 +270,24 @@ func (p *MomentoProvider) Configure(ctx context.Context, req provider.ConfigureR
 	resp.DataSourceData = MomentoClients{
 		cache:         cacheClient,
 		leaderboard:   leaderboardClient,
+		storage:       storageClient,
 		httpClient:    httpClient,
 		httpEndpoint:  httpEndpoint,
 		httpAuthToken: httpAuthToken,
+		apiKey:        apiKey,
+		v2Endpoint:    v2Endpoint,
+		defaultTags:   defaultTags,
 	}
 	resp.ResourceData = MomentoClients{
 		cache:         cacheClient,
 		leaderboard:   leaderboardClient,
+		storage:       storageClient,
 		httpClient:    httpClient,
 		httpEndpoint:  httpEndpoint,
 		httpAuthToken: httpAuthToken,
+		apiKey:        apiKey,
+		v2Endpoint:    v2Endpoint,
+		defaultTags:   defaultTags,
 	}
 }
 
@@ -229,14 +295,30 @@ func (p *MomentoProvider) Resources(ctx context.Context) []func() resource.Resou
 	return []func() resource.Resource{
 		NewCacheResource,
 		NewLeaderboardResource,
+		NewLeaderboardElementsResource,
 		NewValkeyClusterResource,
+		NewValkeyClusterWaiterResource,
+		NewValkeyClusterFailoverResource,
+		NewValkeyClusterRebootResource,
+		NewValkeyClusterAutoscalingResource,
+		NewValkeyClusterSnapshotResource,
+		NewValkeyParameterGroupResource,
+		NewValkeyUserResource,
 		NewObjectStoreResource,
+		NewStoreResource,
 	}
 }
 
 func (p *MomentoProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewCachesDataSource,
+		NewTokenInfoDataSource,
+		NewStoresDataSource,
+		NewLeaderboardDataSource,
+		NewValkeyClustersDataSource,
+		NewValkeyClusterDataSource,
+		NewValkeyNodeTypesDataSource,
+		NewValkeyCapacityPlanDataSource,
 	}
 }
 
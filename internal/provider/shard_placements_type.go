@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ basetypes.ListTypable                    = ShardPlacementsType{}
+	_ basetypes.ListValuableWithSemanticEquals = ShardPlacementsValue{}
+)
+
+// ShardPlacementsType is a list of shard placements whose values are equal regardless of the order of the
+// placements and of their replica availability zones. The API may return placements in a different order than they
+// were configured, which would otherwise show up as changes.
+type ShardPlacementsType struct {
+	basetypes.ListType
+}
+
+func NewShardPlacementsType() ShardPlacementsType {
+	return ShardPlacementsType{
+		ListType: basetypes.ListType{ElemType: types.ObjectType{AttrTypes: valkeyClusterShardPlacementAttrTypes}},
+	}
+}
+
+func (t ShardPlacementsType) Equal(o attr.Type) bool {
+	other, ok := o.(ShardPlacementsType)
+	if !ok {
+		return false
+	}
+	return t.ListType.Equal(other.ListType)
+}
+
+func (t ShardPlacementsType) String() string {
+	return "ShardPlacementsType"
+}
+
+func (t ShardPlacementsType) ValueFromList(ctx context.Context, in basetypes.ListValue) (basetypes.ListValuable, diag.Diagnostics) {
+	return ShardPlacementsValue{ListValue: in}, nil
+}
+
+func (t ShardPlacementsType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.ListType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	listValue, ok := attrValue.(basetypes.ListValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	return ShardPlacementsValue{ListValue: listValue}, nil
+}
+
+func (t ShardPlacementsType) ValueType(ctx context.Context) attr.Value {
+	return ShardPlacementsValue{}
+}
+
+// ShardPlacementsValue is the value of a ShardPlacementsType.
+type ShardPlacementsValue struct {
+	basetypes.ListValue
+}
+
+func NewShardPlacementsValueNull() ShardPlacementsValue {
+	return ShardPlacementsValue{ListValue: types.ListNull(types.ObjectType{AttrTypes: valkeyClusterShardPlacementAttrTypes})}
+}
+
+func NewShardPlacementsValueUnknown() ShardPlacementsValue {
+	return ShardPlacementsValue{ListValue: types.ListUnknown(types.ObjectType{AttrTypes: valkeyClusterShardPlacementAttrTypes})}
+}
+
+func (v ShardPlacementsValue) Equal(o attr.Value) bool {
+	other, ok := o.(ShardPlacementsValue)
+	if !ok {
+		return false
+	}
+	return v.ListValue.Equal(other.ListValue)
+}
+
+func (v ShardPlacementsValue) Type(ctx context.Context) attr.Type {
+	return NewShardPlacementsType()
+}
+
+// ListSemanticEquals keeps the prior placements when the new placements only differ in their order.
+func (v ShardPlacementsValue) ListSemanticEquals(ctx context.Context, newValuable basetypes.ListValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	newValue, ok := newValuable.(ShardPlacementsValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+	if !shardPlacementsComparable(ctx, v) || !shardPlacementsComparable(ctx, newValue) {
+		return false, diags
+	}
+
+	var prior, current []ShardPlacementModel
+	diags.Append(v.ElementsAs(ctx, &prior, false)...)
+	diags.Append(newValue.ElementsAs(ctx, &current, false)...)
+	if diags.HasError() {
+		return false, diags
+	}
+	return shardPlacementsEqual(prior, current), diags
+}
+
+// shardPlacementsComparable reports whether the placements can be decoded to be compared, which requires them to be
+// fully known and to not hold null placements.
+func shardPlacementsComparable(ctx context.Context, v ShardPlacementsValue) bool {
+	if v.IsNull() || v.IsUnknown() {
+		return false
+	}
+	tfValue, err := v.ToTerraformValue(ctx)
+	if err != nil || !tfValue.IsFullyKnown() {
+		return false
+	}
+	for _, element := range v.Elements() {
+		if element.IsNull() {
+			return false
+		}
+	}
+	return true
+}
+
+// shardPlacementsEqual reports whether two sets of placements place every shard index in the same primary
+// availability zone and its replicas in the same availability zones, in any order.
+func shardPlacementsEqual(a []ShardPlacementModel, b []ShardPlacementModel) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	byIndex := make(map[int64]ShardPlacementModel, len(a))
+	for _, sp := range a {
+		byIndex[sp.Index.ValueInt64()] = sp
+	}
+	// Duplicate indexes cannot be matched up
+	if len(byIndex) != len(a) {
+		return false
+	}
+	for _, sp := range b {
+		other, ok := byIndex[sp.Index.ValueInt64()]
+		if !ok || other.AvailabilityZone.ValueString() != sp.AvailabilityZone.ValueString() {
+			return false
+		}
+		if !sameAvailabilityZones(other.ReplicaAvailabilityZones, sp.ReplicaAvailabilityZones) {
+			return false
+		}
+		delete(byIndex, sp.Index.ValueInt64())
+	}
+	return len(byIndex) == 0
+}
+
+// sameAvailabilityZones compares two lists of availability zones as multisets.
+func sameAvailabilityZones(a []types.String, b []types.String) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	counts := make(map[string]int, len(a))
+	for _, az := range a {
+		counts[az.ValueString()]++
+	}
+	for _, az := range b {
+		if counts[az.ValueString()] == 0 {
+			return false
+		}
+		counts[az.ValueString()]--
+	}
+	return true
+}
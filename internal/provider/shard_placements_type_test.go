@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var testShardPlacementObjectType = types.ObjectType{AttrTypes: valkeyClusterShardPlacementAttrTypes}
+
+func testShardPlacementObject(t *testing.T, index int64, availabilityZone string, replicaAvailabilityZones attr.Value) attr.Value {
+	t.Helper()
+	object, diags := types.ObjectValue(valkeyClusterShardPlacementAttrTypes, map[string]attr.Value{
+		"index":                      types.Int64Value(index),
+		"availability_zone":          types.StringValue(availabilityZone),
+		"replica_availability_zones": replicaAvailabilityZones,
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	return object
+}
+
+func testAvailabilityZoneList(t *testing.T, availabilityZones ...attr.Value) attr.Value {
+	t.Helper()
+	list, diags := types.ListValue(types.StringType, availabilityZones)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	return list
+}
+
+func testShardPlacementsValue(t *testing.T, placements ...attr.Value) ShardPlacementsValue {
+	t.Helper()
+	list, diags := types.ListValue(testShardPlacementObjectType, placements)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	return ShardPlacementsValue{ListValue: list}
+}
+
+func TestShardPlacementsValueListSemanticEquals(t *testing.T) {
+	a, b, c := types.StringValue("usw2-az1"), types.StringValue("usw2-az2"), types.StringValue("usw2-az3")
+	shard0 := testShardPlacementObject(t, 0, "usw2-az1", testAvailabilityZoneList(t, b, c))
+	shard1 := testShardPlacementObject(t, 1, "usw2-az2", testAvailabilityZoneList(t, a))
+	placements := testShardPlacementsValue(t, shard0, shard1)
+
+	tests := []struct {
+		name     string
+		newValue ShardPlacementsValue
+		want     bool
+	}{
+		{name: "identical", newValue: testShardPlacementsValue(t, shard0, shard1), want: true},
+		{name: "reordered placements", newValue: testShardPlacementsValue(t, shard1, shard0), want: true},
+		{name: "reordered replicas", newValue: testShardPlacementsValue(t, testShardPlacementObject(t, 0, "usw2-az1", testAvailabilityZoneList(t, c, b)), shard1), want: true},
+		{name: "different replica", newValue: testShardPlacementsValue(t, testShardPlacementObject(t, 0, "usw2-az1", testAvailabilityZoneList(t, b, b)), shard1)},
+		{name: "different primary", newValue: testShardPlacementsValue(t, testShardPlacementObject(t, 0, "usw2-az3", testAvailabilityZoneList(t, b, c)), shard1)},
+		{name: "different replica count", newValue: testShardPlacementsValue(t, testShardPlacementObject(t, 0, "usw2-az1", testAvailabilityZoneList(t, b)), shard1)},
+		{name: "different placement count", newValue: testShardPlacementsValue(t, shard0)},
+		{name: "null", newValue: NewShardPlacementsValueNull()},
+		{name: "unknown", newValue: NewShardPlacementsValueUnknown()},
+		{name: "null placement", newValue: testShardPlacementsValue(t, shard0, types.ObjectNull(valkeyClusterShardPlacementAttrTypes))},
+		{name: "unknown placement", newValue: testShardPlacementsValue(t, shard0, types.ObjectUnknown(valkeyClusterShardPlacementAttrTypes))},
+		{name: "unknown replica", newValue: testShardPlacementsValue(t, testShardPlacementObject(t, 0, "usw2-az1", testAvailabilityZoneList(t, b, types.StringUnknown())), shard1)},
+		{name: "null replicas", newValue: testShardPlacementsValue(t, testShardPlacementObject(t, 0, "usw2-az1", types.ListNull(types.StringType)), shard1)},
+		{name: "unknown replicas", newValue: testShardPlacementsValue(t, testShardPlacementObject(t, 0, "usw2-az1", types.ListUnknown(types.StringType)), shard1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := placements.ListSemanticEquals(context.Background(), tt.newValue)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got != tt.want {
+				t.Errorf("ListSemanticEquals() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestShardPlacementsValueListSemanticEqualsFromUnknown(t *testing.T) {
+	shard0 := testShardPlacementObject(t, 0, "usw2-az1", testAvailabilityZoneList(t))
+	equal, diags := NewShardPlacementsValueUnknown().ListSemanticEquals(context.Background(), testShardPlacementsValue(t, shard0))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if equal {
+		t.Error("ListSemanticEquals() = true, want false")
+	}
+}
+
+func TestShardPlacementsEqual(t *testing.T) {
+	tests := []struct {
+		name string
+		a    []ShardPlacementModel
+		b    []ShardPlacementModel
+		want bool
+	}{
+		{name: "empty", want: true},
+		{
+			name: "reordered placements",
+			a:    []ShardPlacementModel{testShardPlacement(0, "a", "b"), testShardPlacement(1, "b", "a")},
+			b:    []ShardPlacementModel{testShardPlacement(1, "b", "a"), testShardPlacement(0, "a", "b")},
+			want: true,
+		},
+		{
+			name: "reordered replicas",
+			a:    []ShardPlacementModel{testShardPlacement(0, "a", "b", "c")},
+			b:    []ShardPlacementModel{testShardPlacement(0, "a", "c", "b")},
+			want: true,
+		},
+		{
+			name: "repeated replica zones are counted",
+			a:    []ShardPlacementModel{testShardPlacement(0, "a", "b", "b", "c")},
+			b:    []ShardPlacementModel{testShardPlacement(0, "a", "b", "c", "c")},
+		},
+		{
+			name: "different replica count",
+			a:    []ShardPlacementModel{testShardPlacement(0, "a", "b", "c")},
+			b:    []ShardPlacementModel{testShardPlacement(0, "a", "b")},
+		},
+		{
+			name: "different placement count",
+			a:    []ShardPlacementModel{testShardPlacement(0, "a"), testShardPlacement(1, "b")},
+			b:    []ShardPlacementModel{testShardPlacement(0, "a")},
+		},
+		{
+			name: "different primary",
+			a:    []ShardPlacementModel{testShardPlacement(0, "a", "b")},
+			b:    []ShardPlacementModel{testShardPlacement(0, "b", "b")},
+		},
+		{
+			name: "different index",
+			a:    []ShardPlacementModel{testShardPlacement(0, "a")},
+			b:    []ShardPlacementModel{testShardPlacement(1, "a")},
+		},
+		{
+			name: "duplicate indexes",
+			a:    []ShardPlacementModel{testShardPlacement(0, "a"), testShardPlacement(0, "a")},
+			b:    []ShardPlacementModel{testShardPlacement(0, "a"), testShardPlacement(1, "a")},
+		},
+		{
+			name: "null replica zone",
+			a:    []ShardPlacementModel{{Index: types.Int64Value(0), AvailabilityZone: types.StringValue("a"), ReplicaAvailabilityZones: []types.String{types.StringNull()}}},
+			b:    []ShardPlacementModel{testShardPlacement(0, "a", "b")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := shardPlacementsEqual(tt.a, tt.b); got != tt.want {
+				t.Errorf("shardPlacementsEqual(a, b) = %v, want %v", got, tt.want)
+			}
+			if got := shardPlacementsEqual(tt.b, tt.a); got != tt.want {
+				t.Errorf("shardPlacementsEqual(b, a) = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,285 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/momentohq/client-sdk-go/momento"
+	"github.com/momentohq/client-sdk-go/responses"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &StoreResource{}
+	_ resource.ResourceWithConfigure   = &StoreResource{}
+	_ resource.ResourceWithImportState = &StoreResource{}
+	_ resource.ResourceWithModifyPlan  = &StoreResource{}
+)
+
+func NewStoreResource() resource.Resource {
+	return &StoreResource{}
+}
+
+// StoreResource defines the resource implementation.
+type StoreResource struct {
+	client        *momento.PreviewStorageClient
+	httpClient    *http.Client
+	httpEndpoint  string
+	httpAuthToken string
+	v2Endpoint    string
+	defaultTags   map[string]string
+}
+
+// StoreResourceModel describes the resource data model.
+type StoreResourceModel struct {
+	Name    types.String `tfsdk:"name"`
+	Id      types.String `tfsdk:"id"`
+	Tags    types.Map    `tfsdk:"tags"`
+	TagsAll types.Map    `tfsdk:"tags_all"`
+}
+
+func (r *StoreResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_store"
+}
+
+func (r *StoreResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A Momento persistent storage store.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the store.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			// The testing framework requires an id attribute to be present in every data source and resource
+			"id": schema.StringAttribute{
+				Description: "The ID of the store.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tags": schema.MapAttribute{
+				MarkdownDescription: "Tags to assign to the store. Tags override provider `default_tags` with the same key. Changing the tags updates the store in place.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"tags_all": schema.MapAttribute{
+				MarkdownDescription: "All tags of the store, including those inherited from the provider `default_tags`.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *StoreResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(MomentoClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected MomentoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	client := clients.storage
+
+	r.client = &client
+	r.httpClient = clients.httpClient
+	r.httpEndpoint = clients.httpEndpoint
+	r.httpAuthToken = clients.httpAuthToken
+	r.v2Endpoint = clients.v2Endpoint
+	r.defaultTags = clients.defaultTags
+}
+
+func (r *StoreResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Skip Delete (plan null).
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(planTagsAll(ctx, r.defaultTags, &resp.Plan)...)
+}
+
+func (r *StoreResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan StoreResourceModel
+
+	// Retrieve values from the plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new store
+	client := *r.client
+	createResp, err := client.CreateStore(ctx, &momento.CreateStoreRequest{
+		StoreName: plan.Name.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create store, got error: %s", err))
+		return
+	}
+
+	switch createResp.(type) {
+	case *responses.CreateStoreSuccess:
+		break
+	case *responses.CreateStoreAlreadyExists:
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create store, store with name \"%s\" already exists", plan.Name.ValueString()))
+		return
+	default:
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create store, got unknown response type: %T", createResp))
+		return
+	}
+
+	// Map response body to schema and populate computed attribute values
+	plan.Id = types.StringValue(plan.Name.ValueString())
+
+	// Tags are assigned once the store exists
+	tagsAllValue, diags := tagsAll(ctx, r.defaultTags, plan.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.TagsAll = tagsAllValue
+	if len(plan.TagsAll.Elements()) > 0 {
+		if err := putResourceTags(*r.httpClient, "store/"+plan.Name.ValueString(), tagsFromMap(plan.TagsAll), r.httpEndpoint, r.httpAuthToken); err != nil {
+			// The store was created, so it is saved without its tags, which are assigned by the next apply
+			plan.TagsAll = types.MapNull(types.StringType)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to tag store, got error: %s", err))
+			return
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *StoreResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state StoreResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Find store
+	client := *r.client
+	stores, err := listStores(ctx, client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list stores, got error: %s", err))
+		return
+	}
+	found := false
+	for _, store := range stores {
+		if store == state.Name.ValueString() {
+			found = true
+			break
+		}
+	}
+	if !found {
+		resp.Diagnostics.AddWarning("Store Not Found", fmt.Sprintf("Store with name \"%s\" not found, removing from state", state.Name.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Id = types.StringValue(state.Name.ValueString())
+
+	if tagsApiRequired(r.v2Endpoint, state.TagsAll) {
+		tags, err := describeResourceTags(*r.httpClient, "store/"+state.Name.ValueString(), r.httpEndpoint, r.httpAuthToken)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read store tags, got error: %s", err))
+			return
+		}
+		resp.Diagnostics.Append(refreshTags(ctx, tags, r.defaultTags, &state.Tags, &state.TagsAll)...)
+	}
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *StoreResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state StoreResourceModel
+
+	// Retrieve values from the plan. Changing the name replaces the store, so only the tags are updated.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tagsAllValue, diags := tagsAll(ctx, r.defaultTags, plan.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.TagsAll = tagsAllValue
+	if tagsApiRequired(r.v2Endpoint, plan.TagsAll, state.TagsAll) {
+		if err := putResourceTags(*r.httpClient, "store/"+plan.Name.ValueString(), tagsFromMap(plan.TagsAll), r.httpEndpoint, r.httpAuthToken); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update store tags, got error: %s", err))
+			return
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *StoreResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state StoreResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete store
+	client := *r.client
+	deleteResp, err := client.DeleteStore(ctx, &momento.DeleteStoreRequest{
+		StoreName: state.Name.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete store, got error: %s", err))
+		return
+	}
+
+	switch deleteResp.(type) {
+	case *responses.DeleteStoreSuccess:
+		break
+	default:
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete store, got unknown response type: %T", deleteResp))
+		return
+	}
+}
+
+func (r *StoreResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
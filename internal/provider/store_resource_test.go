@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestCreateStoreResource(t *testing.T) {
+	storeName1 := "terraform-provider-momento-test-" + acctest.RandString(8)
+	storeName2 := "terraform-provider-momento-test-" + acctest.RandString(8)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		// Each TestStep represents one `terraform apply`
+		Steps: []resource.TestStep{
+			// Create and Read one store
+			{
+				Config: testAccStoreResourceConfig(storeName1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("momento_store.test", "Create"),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("momento_store.test", "name", storeName1),
+					resource.TestCheckResourceAttr("momento_store.test", "id", storeName1),
+				),
+			},
+			// Creating a store should be idempotent (no new store should be created on this second call)
+			{
+				Config: testAccStoreResourceConfig(storeName1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("momento_store.test", "NoOp"),
+					},
+				},
+			},
+			// Updating the config with new store name should destroy the old store and create a new one
+			{
+				Config: testAccStoreResourceConfig(storeName2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("momento_store.test", "DestroyBeforeCreate"),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("momento_store.test", "name", storeName2),
+					resource.TestCheckResourceAttr("momento_store.test", "id", storeName2),
+				),
+			},
+			// Test ImportState method (imports existing resources)
+			{
+				ResourceName:      "momento_store.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// The data source should find the store when filtering by its exact name
+			{
+				Config: testAccStoreResourceConfig(storeName2) + testAccStoresDataSourceConfig(storeName2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.momento_stores.test", "stores.#", "1"),
+					resource.TestCheckResourceAttr("data.momento_stores.test", "stores.0.name", storeName2),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccStoreResourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "momento_store" "test" {
+  name = %[1]q
+}
+`, name)
+}
+
+func testAccStoresDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+data "momento_stores" "test" {
+  name_regex = "^%[1]s$"
+  depends_on = [momento_store.test]
+}
+`, name)
+}
@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/momentohq/client-sdk-go/momento"
+	"github.com/momentohq/client-sdk-go/responses"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &StoresDataSource{}
+	_ datasource.DataSourceWithConfigure = &StoresDataSource{}
+)
+
+func NewStoresDataSource() datasource.DataSource {
+	return &StoresDataSource{}
+}
+
+// StoresDataSource defines the data source implementation.
+type StoresDataSource struct {
+	client *momento.PreviewStorageClient
+}
+
+// StoresDataSourceModel describes the data source data model.
+type StoresDataSourceModel struct {
+	Id         types.String                 `tfsdk:"id"`
+	NamePrefix types.String                 `tfsdk:"name_prefix"`
+	NameRegex  types.String                 `tfsdk:"name_regex"`
+	Stores     []StoresDataSourceStoreModel `tfsdk:"stores"`
+}
+
+type StoresDataSourceStoreModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+func (d *StoresDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stores"
+}
+
+func (d *StoresDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A list of Momento persistent storage stores.",
+
+		Attributes: map[string]schema.Attribute{
+			// The testing framework requires an id attribute to be present in every data source and resource
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+			"name_prefix": schema.StringAttribute{
+				MarkdownDescription: "Only return stores whose name starts with this prefix.",
+				Optional:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only return stores whose name matches this regular expression.",
+				Optional:            true,
+			},
+			"stores": schema.ListNestedAttribute{
+				Description: "List of stores.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the store.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *StoresDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(MomentoClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected MomentoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	client := clients.storage
+
+	d.client = &client
+}
+
+func (d *StoresDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data StoresDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Retrieve data from the API
+	client := *d.client
+	stores, err := listStores(ctx, client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to list stores, got error: %s", err.Error()),
+		)
+		return
+	}
+
+	stores, err = filterNames(stores, data.NamePrefix, data.NameRegex)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid value", fmt.Sprintf("Unable to compile name_regex: %s", err))
+		return
+	}
+
+	// Save data into the model
+	for _, store := range stores {
+		data.Stores = append(data.Stores, StoresDataSourceStoreModel{
+			Name: types.StringValue(store),
+		})
+	}
+
+	data.Id = types.StringValue("placeholder")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func listStores(ctx context.Context, client momento.PreviewStorageClient) ([]string, error) {
+	var stores []string
+	resp, err := client.ListStores(ctx, &momento.ListStoresRequest{})
+	if err != nil {
+		return nil, err
+	}
+	if r, ok := resp.(*responses.ListStoresSuccess); ok {
+		for _, storeInfo := range r.Stores() {
+			stores = append(stores, storeInfo.Name())
+		}
+	} else {
+		return nil, fmt.Errorf("unexpected response type %T", resp)
+	}
+	return stores, nil
+}
@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Resources with tags have a configurable `tags` attribute and a computed `tags_all` attribute, which merges in the
+// provider default_tags. tags_all is what is sent to and read from the API.
+
+// tagsApiRequired reports whether the tags API has to be called for a resource with the given tags_all values. The
+// tags API is only reachable with a V2 API key and endpoint, so without them it is only called for resources that
+// have or had tags, and resources without tags keep the tags they are configured with.
+func tagsApiRequired(v2Endpoint string, tagsAll ...types.Map) bool {
+	if v2Endpoint != "" {
+		return true
+	}
+	for _, all := range tagsAll {
+		if len(all.Elements()) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// tagsFromMap returns the known tags of a map value, which is empty for a null map.
+func tagsFromMap(tags types.Map) map[string]string {
+	result := make(map[string]string, len(tags.Elements()))
+	for key, value := range tags.Elements() {
+		if s, ok := value.(types.String); ok && !s.IsNull() && !s.IsUnknown() {
+			result[key] = s.ValueString()
+		}
+	}
+	return result
+}
+
+// tagsAll merges the provider default tags with the tags of a resource, the resource tags taking precedence. The
+// result is unknown while any of the resource tags is unknown, and null when there are no tags.
+func tagsAll(ctx context.Context, defaultTags map[string]string, tags types.Map) (types.Map, diag.Diagnostics) {
+	if tags.IsUnknown() {
+		return types.MapUnknown(types.StringType), nil
+	}
+	for _, value := range tags.Elements() {
+		if value.IsUnknown() {
+			return types.MapUnknown(types.StringType), nil
+		}
+	}
+
+	merged := make(map[string]string, len(defaultTags)+len(tags.Elements()))
+	for key, value := range defaultTags {
+		merged[key] = value
+	}
+	for key, value := range tagsFromMap(tags) {
+		merged[key] = value
+	}
+	if len(merged) == 0 {
+		return types.MapNull(types.StringType), nil
+	}
+	return types.MapValueFrom(ctx, types.StringType, merged)
+}
+
+// planTagsAll sets tags_all in the plan from the planned tags.
+func planTagsAll(ctx context.Context, defaultTags map[string]string, plan *tfsdk.Plan) diag.Diagnostics {
+	var tags types.Map
+	diags := plan.GetAttribute(ctx, path.Root("tags"), &tags)
+	if diags.HasError() {
+		return diags
+	}
+	all, d := tagsAll(ctx, defaultTags, tags)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(plan.SetAttribute(ctx, path.Root("tags_all"), all)...)
+	return diags
+}
+
+// refreshTags sets tags_all to the tags read from the API, and tags to those that are not inherited from the provider
+// default_tags. Tags that are configured on the resource are kept in tags even when a default tag has the same value,
+// and tags added outside of Terraform show up in tags so that they are planned to be removed.
+func refreshTags(ctx context.Context, remote map[string]string, defaultTags map[string]string, tags *types.Map, all *types.Map) diag.Diagnostics {
+	var diags diag.Diagnostics
+	configured := tags.Elements()
+	resourceTags := make(map[string]string, len(remote))
+	for key, value := range remote {
+		if _, ok := configured[key]; !ok {
+			if defaultValue, ok := defaultTags[key]; ok && defaultValue == value {
+				continue
+			}
+		}
+		resourceTags[key] = value
+	}
+
+	if len(resourceTags) > 0 || !tags.IsNull() {
+		value, d := types.MapValueFrom(ctx, types.StringType, resourceTags)
+		diags.Append(d...)
+		*tags = value
+	}
+	*all = types.MapNull(types.StringType)
+	if len(remote) > 0 {
+		value, d := types.MapValueFrom(ctx, types.StringType, remote)
+		diags.Append(d...)
+		*all = value
+	}
+	return diags
+}
+
+type ResourceTagsData struct {
+	Tags map[string]string `json:"tags"`
+}
+
+// GET /<resource-path>/tags
+// Expected response: {"tags": {"<key>": "<value>"}}
+func describeResourceTags(client http.Client, resourcePath string, httpEndpoint string, httpAuthToken string) (map[string]string, error) {
+	getRequest, err := http.NewRequest("GET", fmt.Sprintf("%s/%s/tags", httpEndpoint, resourcePath), nil)
+	if err != nil {
+		return nil, err
+	}
+	getRequest.Header.Set("Authorization", httpAuthToken)
+	getResp, err := client.Do(getRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = getResp.Body.Close() }()
+	if getResp.StatusCode >= 300 {
+		body, _ := io.ReadAll(getResp.Body)
+		return nil, fmt.Errorf("unable to describe tags, got non-2xx response: %s %s", getResp.Status, string(body))
+	}
+
+	bodyBytes, err := io.ReadAll(getResp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %v", err)
+	}
+
+	var tags ResourceTagsData
+	err = json.Unmarshal(bodyBytes, &tags)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling JSON: %v", err)
+	}
+	return tags.Tags, nil
+}
+
+// PUT /<resource-path>/tags
+// Required fields: tags, which replace all tags of the resource
+// Expected response: 2xx
+func putResourceTags(client http.Client, resourcePath string, tags map[string]string, httpEndpoint string, httpAuthToken string) error {
+	requestJson, err := json.Marshal(ResourceTagsData{Tags: tags})
+	if err != nil {
+		return err
+	}
+
+	putRequest, err := http.NewRequest("PUT", fmt.Sprintf("%s/%s/tags", httpEndpoint, resourcePath), bytes.NewBuffer(requestJson))
+	if err != nil {
+		return err
+	}
+	putRequest.Header.Set("Authorization", httpAuthToken)
+	putRequest.Header.Set("Content-Type", "application/json")
+
+	httpResp, err := client.Do(putRequest)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = httpResp.Body.Close() }()
+	if httpResp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(httpResp.Body)
+		return fmt.Errorf("unable to update tags, got non-2xx response: %s %s", httpResp.Status, string(respBody))
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &TokenInfoDataSource{}
+	_ datasource.DataSourceWithConfigure = &TokenInfoDataSource{}
+)
+
+func NewTokenInfoDataSource() datasource.DataSource {
+	return &TokenInfoDataSource{}
+}
+
+// TokenInfoDataSource defines the data source implementation.
+type TokenInfoDataSource struct {
+	apiKey     string
+	v2Endpoint string
+}
+
+// TokenInfoDataSourceModel describes the data source data model.
+type TokenInfoDataSourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	ApiKey      types.String `tfsdk:"api_key"`
+	KeyType     types.String `tfsdk:"key_type"`
+	Endpoint    types.String `tfsdk:"endpoint"`
+	Cell        types.String `tfsdk:"cell"`
+	ExpiresAt   types.String `tfsdk:"expires_at"`
+	Permissions types.String `tfsdk:"permissions"`
+}
+
+func (d *TokenInfoDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_token_info"
+}
+
+func (d *TokenInfoDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Information decoded from a Momento disposable token, legacy API key or V2 API key. The key is decoded locally and the Momento service is not called, so the key is not verified.",
+
+		Attributes: map[string]schema.Attribute{
+			// The testing framework requires an id attribute to be present in every data source and resource
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+			"api_key": schema.StringAttribute{
+				MarkdownDescription: "The key to decode. If not specified, the credential the provider is configured with is decoded.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"key_type": schema.StringAttribute{
+				MarkdownDescription: "The type of the key. One of `disposable_token`, `legacy_api_key` (v1 and older API keys) or `v2_api_key`.",
+				Computed:            true,
+			},
+			"endpoint": schema.StringAttribute{
+				MarkdownDescription: "The Momento endpoint the key is bound to. For V2 API keys decoded from the provider configuration this is the configured `v2_api_endpoint`.",
+				Computed:            true,
+			},
+			"cell": schema.StringAttribute{
+				MarkdownDescription: "The Momento cell derived from the endpoint.",
+				Computed:            true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "The RFC 3339 timestamp at which the key expires. Null if the key never expires.",
+				Computed:            true,
+			},
+			"permissions": schema.StringAttribute{
+				MarkdownDescription: "The JSON encoded permissions granted by the key, if the key carries any. Use `jsondecode` to inspect them.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *TokenInfoDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(MomentoClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected MomentoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.apiKey = clients.apiKey
+	d.v2Endpoint = clients.v2Endpoint
+}
+
+func (d *TokenInfoDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TokenInfoDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An explicitly supplied key carries no endpoint of its own unless it embeds one.
+	apiKey, endpoint := d.apiKey, d.v2Endpoint
+	if !data.ApiKey.IsNull() {
+		apiKey, endpoint = data.ApiKey.ValueString(), ""
+	}
+	if apiKey == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_key"),
+			"Missing Momento API key",
+			"No api_key was given and the provider is not configured with a Momento credential to decode.",
+		)
+		return
+	}
+
+	info, err := decodeMomentoApiKey(apiKey, endpoint)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("api_key"), "Invalid Momento API key", fmt.Sprintf("Unable to decode the key: %s", err))
+		return
+	}
+
+	data.KeyType = types.StringValue(info.KeyType)
+	data.Endpoint = types.StringNull()
+	data.Cell = types.StringNull()
+	if info.Endpoint != "" {
+		data.Endpoint = types.StringValue(info.Endpoint)
+		data.Cell = types.StringValue(cellFromEndpoint(info.Endpoint))
+	}
+	data.ExpiresAt = types.StringNull()
+	if info.ExpiresAt != nil {
+		data.ExpiresAt = types.StringValue(info.ExpiresAt.UTC().Format(time.RFC3339))
+	}
+	data.Permissions = types.StringNull()
+	if len(info.Permissions) > 0 {
+		data.Permissions = types.StringValue(string(info.Permissions))
+	}
+
+	data.Id = types.StringValue("placeholder")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestTokenInfoDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccTokenInfoDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.momento_token_info.test", "id", "placeholder"),
+					resource.TestCheckResourceAttrSet("data.momento_token_info.test", "key_type"),
+					resource.TestCheckResourceAttrSet("data.momento_token_info.test", "endpoint"),
+					resource.TestCheckResourceAttrSet("data.momento_token_info.test", "cell"),
+				),
+			},
+		},
+	})
+}
+
+const testAccTokenInfoDataSourceConfig = `
+data "momento_token_info" "test" {
+}
+`
@@ -0,0 +1,278 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource                   = &ValkeyCapacityPlanDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &ValkeyCapacityPlanDataSource{}
+)
+
+const (
+	// valkeyUsableMemoryFraction is the share of node memory available to the dataset, the rest being reserved for
+	// replication buffers, snapshots and fragmentation.
+	valkeyUsableMemoryFraction = 0.75
+	// valkeyOpsPerVcpu is a conservative estimate of the operations per second a node sustains per vCPU.
+	valkeyOpsPerVcpu = 25000
+	// valkeyMaxReplicationFactor and valkeyMaxNodes are the limits of a Valkey Cluster.
+	valkeyMaxReplicationFactor = 5
+	valkeyMaxNodes             = 500
+)
+
+// valkeyCapacityRequirements are the inputs of a capacity plan.
+type valkeyCapacityRequirements struct {
+	DatasetSizeGib   float64
+	PeakOpsPerSecond int64
+	ReadPercent      int64
+	MultiAz          bool
+	HeadroomPercent  int64
+}
+
+// valkeyCapacityPlan is a recommended cluster layout.
+type valkeyCapacityPlan struct {
+	NodeType          valkeyNodeType
+	ShardCount        int64
+	ReplicationFactor int64
+}
+
+func (p valkeyCapacityPlan) nodeCount() int64 {
+	return p.ShardCount * (1 + p.ReplicationFactor)
+}
+
+// planValkeyCapacity returns the layout with the fewest total vCPUs that holds the dataset and serves the peak load,
+// both grown by the headroom. Writes are served by the primaries, while reads are spread over every node of a shard.
+// Burstable node types are not considered, since they cannot sustain a peak load.
+func planValkeyCapacity(requirements valkeyCapacityRequirements) (*valkeyCapacityPlan, error) {
+	growth := 1 + float64(requirements.HeadroomPercent)/100
+	memoryGib := requirements.DatasetSizeGib * growth
+	writeOps := float64(requirements.PeakOpsPerSecond) * float64(100-requirements.ReadPercent) / 100 * growth
+	totalOps := float64(requirements.PeakOpsPerSecond) * growth
+	minReplicationFactor := int64(0)
+	if requirements.MultiAz {
+		minReplicationFactor = 1
+	}
+
+	var best *valkeyCapacityPlan
+	for _, nodeType := range valkeyNodeTypes {
+		if nodeType.Family == "t3" || nodeType.Family == "t4g" {
+			continue
+		}
+		nodeOps := float64(nodeType.Vcpus * valkeyOpsPerVcpu)
+		shardCount := max(1,
+			int64(math.Ceil(memoryGib/(nodeType.MemoryGib*valkeyUsableMemoryFraction))),
+			int64(math.Ceil(writeOps/nodeOps)),
+		)
+		// Add replicas to serve reads, and shards once replicas are exhausted
+		replicationFactor := minReplicationFactor
+		for float64(shardCount*(1+replicationFactor))*nodeOps < totalOps {
+			if replicationFactor < valkeyMaxReplicationFactor {
+				replicationFactor++
+			} else {
+				shardCount++
+			}
+		}
+		candidate := valkeyCapacityPlan{NodeType: nodeType, ShardCount: shardCount, ReplicationFactor: replicationFactor}
+		if candidate.nodeCount() > valkeyMaxNodes {
+			continue
+		}
+		if best == nil || isCheaperValkeyCapacityPlan(candidate, *best) {
+			best = &candidate
+		}
+	}
+	if best == nil {
+		return nil, fmt.Errorf("no layout of at most %d nodes holds %.1f GiB and serves %.0f operations per second", valkeyMaxNodes, memoryGib, totalOps)
+	}
+	return best, nil
+}
+
+// isCheaperValkeyCapacityPlan compares plans by total vCPUs, then total memory, then number of nodes.
+func isCheaperValkeyCapacityPlan(a valkeyCapacityPlan, b valkeyCapacityPlan) bool {
+	aVcpus, bVcpus := a.nodeCount()*a.NodeType.Vcpus, b.nodeCount()*b.NodeType.Vcpus
+	if aVcpus != bVcpus {
+		return aVcpus < bVcpus
+	}
+	aMemory, bMemory := float64(a.nodeCount())*a.NodeType.MemoryGib, float64(b.nodeCount())*b.NodeType.MemoryGib
+	if aMemory != bMemory {
+		return aMemory < bMemory
+	}
+	return a.nodeCount() < b.nodeCount()
+}
+
+// rationale explains how the plan meets the requirements.
+func (p valkeyCapacityPlan) rationale(requirements valkeyCapacityRequirements) string {
+	growth := 1 + float64(requirements.HeadroomPercent)/100
+	availability := "single-AZ, without replicas required for availability"
+	if requirements.MultiAz {
+		availability = "multi-AZ, with at least one replica per shard in another availability zone"
+	}
+	return fmt.Sprintf(
+		"%d shards of %s (%d vCPUs, %.2f GiB) with a replication factor of %d, %d nodes in total. "+
+			"The primaries provide %.1f GiB for a %.1f GiB dataset with %d%% headroom (%.1f GiB), assuming %.0f%% of node memory is usable. "+
+			"The nodes serve an estimated %d operations per second for a peak of %d with %d%% headroom (%.0f), of which %d%% are reads spread over all nodes and the rest writes served by primaries. "+
+			"The layout is %s.",
+		p.ShardCount, p.NodeType.Name, p.NodeType.Vcpus, p.NodeType.MemoryGib, p.ReplicationFactor, p.nodeCount(),
+		float64(p.ShardCount)*p.NodeType.MemoryGib*valkeyUsableMemoryFraction, requirements.DatasetSizeGib, requirements.HeadroomPercent, requirements.DatasetSizeGib*growth, valkeyUsableMemoryFraction*100,
+		p.nodeCount()*p.NodeType.Vcpus*valkeyOpsPerVcpu, requirements.PeakOpsPerSecond, requirements.HeadroomPercent, float64(requirements.PeakOpsPerSecond)*growth, requirements.ReadPercent,
+		availability,
+	)
+}
+
+func NewValkeyCapacityPlanDataSource() datasource.DataSource {
+	return &ValkeyCapacityPlanDataSource{}
+}
+
+// ValkeyCapacityPlanDataSource defines the data source implementation.
+type ValkeyCapacityPlanDataSource struct{}
+
+// ValkeyCapacityPlanDataSourceModel describes the data source data model.
+type ValkeyCapacityPlanDataSourceModel struct {
+	Id                  types.String  `tfsdk:"id"`
+	DatasetSizeGib      types.Float64 `tfsdk:"dataset_size_gib"`
+	PeakOpsPerSecond    types.Int64   `tfsdk:"peak_ops_per_second"`
+	ReadPercent         types.Int64   `tfsdk:"read_percent"`
+	Availability        types.String  `tfsdk:"availability"`
+	HeadroomPercent     types.Int64   `tfsdk:"headroom_percent"`
+	NodeInstanceType    types.String  `tfsdk:"node_instance_type"`
+	ShardCount          types.Int64   `tfsdk:"shard_count"`
+	ReplicationFactor   types.Int64   `tfsdk:"replication_factor"`
+	EnforceShardMultiAz types.Bool    `tfsdk:"enforce_shard_multi_az"`
+	Rationale           types.String  `tfsdk:"rationale"`
+}
+
+func (d *ValkeyCapacityPlanDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_valkey_capacity_plan"
+}
+
+func (d *ValkeyCapacityPlanDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Recommends a `momento_valkey_cluster` layout for a dataset size and peak load. The recommendation is the layout with the fewest total vCPUs among the non-burstable node types listed by `momento_valkey_node_types`, based on conservative estimates of usable memory and throughput per node; validate it with a load test before relying on it. The plan is computed by the provider and does not call the Momento API.",
+
+		Attributes: map[string]schema.Attribute{
+			// The testing framework requires an id attribute to be present in every data source and resource
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+			"dataset_size_gib": schema.Float64Attribute{
+				MarkdownDescription: "The size of the dataset to hold, in GiB.",
+				Required:            true,
+			},
+			"peak_ops_per_second": schema.Int64Attribute{
+				MarkdownDescription: "The peak number of operations per second, reads and writes combined.",
+				Required:            true,
+			},
+			"read_percent": schema.Int64Attribute{
+				MarkdownDescription: "The percentage of operations that are reads, from 0 to 100. Defaults to 80.",
+				Optional:            true,
+			},
+			"availability": schema.StringAttribute{
+				MarkdownDescription: "The desired availability, `single-az` or `multi-az`. `multi-az` places at least one replica of each shard in another availability zone. Defaults to `multi-az`.",
+				Optional:            true,
+			},
+			"headroom_percent": schema.Int64Attribute{
+				MarkdownDescription: "The percentage by which the dataset size and peak load are grown to leave room for growth and spikes. Defaults to 25.",
+				Optional:            true,
+			},
+			"node_instance_type": schema.StringAttribute{
+				MarkdownDescription: "The recommended node instance type.",
+				Computed:            true,
+			},
+			"shard_count": schema.Int64Attribute{
+				MarkdownDescription: "The recommended number of shards.",
+				Computed:            true,
+			},
+			"replication_factor": schema.Int64Attribute{
+				MarkdownDescription: "The recommended number of replicas per shard.",
+				Computed:            true,
+			},
+			"enforce_shard_multi_az": schema.BoolAttribute{
+				MarkdownDescription: "Whether the replicas of each shard should be in other availability zones than its primary.",
+				Computed:            true,
+			},
+			"rationale": schema.StringAttribute{
+				MarkdownDescription: "An explanation of how the recommended layout meets the requirements.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ValkeyCapacityPlanDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var datasetSizeGib types.Float64
+	var peakOpsPerSecond, readPercent, headroomPercent types.Int64
+	var availability types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("dataset_size_gib"), &datasetSizeGib)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("peak_ops_per_second"), &peakOpsPerSecond)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("read_percent"), &readPercent)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("availability"), &availability)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("headroom_percent"), &headroomPercent)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !datasetSizeGib.IsNull() && !datasetSizeGib.IsUnknown() && datasetSizeGib.ValueFloat64() <= 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("dataset_size_gib"), "Invalid value", "dataset_size_gib must be greater than 0.")
+	}
+	if !peakOpsPerSecond.IsNull() && !peakOpsPerSecond.IsUnknown() && peakOpsPerSecond.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("peak_ops_per_second"), "Invalid value", "peak_ops_per_second must not be negative.")
+	}
+	if !readPercent.IsNull() && !readPercent.IsUnknown() && (readPercent.ValueInt64() < 0 || readPercent.ValueInt64() > 100) {
+		resp.Diagnostics.AddAttributeError(path.Root("read_percent"), "Invalid value", "read_percent must be between 0 and 100.")
+	}
+	if !availability.IsNull() && !availability.IsUnknown() && availability.ValueString() != "single-az" && availability.ValueString() != "multi-az" {
+		resp.Diagnostics.AddAttributeError(path.Root("availability"), "Invalid value", fmt.Sprintf("availability must be one of single-az, multi-az, got %q.", availability.ValueString()))
+	}
+	if !headroomPercent.IsNull() && !headroomPercent.IsUnknown() && (headroomPercent.ValueInt64() < 0 || headroomPercent.ValueInt64() > 1000) {
+		resp.Diagnostics.AddAttributeError(path.Root("headroom_percent"), "Invalid value", "headroom_percent must be between 0 and 1000.")
+	}
+}
+
+func (d *ValkeyCapacityPlanDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ValkeyCapacityPlanDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requirements := valkeyCapacityRequirements{
+		DatasetSizeGib:   data.DatasetSizeGib.ValueFloat64(),
+		PeakOpsPerSecond: data.PeakOpsPerSecond.ValueInt64(),
+		ReadPercent:      80,
+		MultiAz:          data.Availability.IsNull() || data.Availability.ValueString() == "multi-az",
+		HeadroomPercent:  25,
+	}
+	if !data.ReadPercent.IsNull() {
+		requirements.ReadPercent = data.ReadPercent.ValueInt64()
+	}
+	if !data.HeadroomPercent.IsNull() {
+		requirements.HeadroomPercent = data.HeadroomPercent.ValueInt64()
+	}
+
+	plan, err := planValkeyCapacity(requirements)
+	if err != nil {
+		resp.Diagnostics.AddError("No Capacity Plan", fmt.Sprintf("Unable to plan a valkey cluster: %s. Consider splitting the dataset over several clusters.", err))
+		return
+	}
+
+	data.Id = types.StringValue("placeholder")
+	data.NodeInstanceType = types.StringValue(plan.NodeType.Name)
+	data.ShardCount = types.Int64Value(plan.ShardCount)
+	data.ReplicationFactor = types.Int64Value(plan.ReplicationFactor)
+	data.EnforceShardMultiAz = types.BoolValue(requirements.MultiAz)
+	data.Rationale = types.StringValue(plan.rationale(requirements))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,438 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &ValkeyClusterAutoscalingResource{}
+	_ resource.ResourceWithConfigure      = &ValkeyClusterAutoscalingResource{}
+	_ resource.ResourceWithImportState    = &ValkeyClusterAutoscalingResource{}
+	_ resource.ResourceWithValidateConfig = &ValkeyClusterAutoscalingResource{}
+)
+
+func NewValkeyClusterAutoscalingResource() resource.Resource {
+	return &ValkeyClusterAutoscalingResource{}
+}
+
+// ValkeyClusterAutoscalingResource defines the resource implementation.
+type ValkeyClusterAutoscalingResource struct {
+	httpClient    *http.Client
+	httpEndpoint  string
+	httpAuthToken string
+}
+
+// ValkeyClusterAutoscalingResourceModel describes the resource data model.
+type ValkeyClusterAutoscalingResourceModel struct {
+	Id                      types.String `tfsdk:"id"`
+	ClusterName             types.String `tfsdk:"cluster_name"`
+	MinShardCount           types.Int64  `tfsdk:"min_shard_count"`
+	MaxShardCount           types.Int64  `tfsdk:"max_shard_count"`
+	MinReplicationFactor    types.Int64  `tfsdk:"min_replication_factor"`
+	MaxReplicationFactor    types.Int64  `tfsdk:"max_replication_factor"`
+	TargetMemoryUtilization types.Int64  `tfsdk:"target_memory_utilization"`
+	TargetCpuUtilization    types.Int64  `tfsdk:"target_cpu_utilization"`
+	ScaleOutCooldown        types.String `tfsdk:"scale_out_cooldown"`
+	ScaleInCooldown         types.String `tfsdk:"scale_in_cooldown"`
+}
+
+func (r *ValkeyClusterAutoscalingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_valkey_cluster_autoscaling"
+}
+
+func (r *ValkeyClusterAutoscalingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "An autoscaling policy for a Valkey Cluster. Momento scales the cluster between the configured bounds to keep memory and CPU utilization near their targets, through the same shard and replica changes the provider makes. Set `ignore_autoscaled_size` on the `momento_valkey_cluster` so that plans do not revert the sizes chosen by the autoscaler. Destroying the policy stops autoscaling and leaves the cluster at its current size.",
+
+		Attributes: map[string]schema.Attribute{
+			// The testing framework requires an id attribute to be present in every data source and resource
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the autoscaling policy, which is the name of the cluster.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the Valkey Cluster to scale. Changing the cluster moves the policy to the new cluster.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"min_shard_count": schema.Int64Attribute{
+				MarkdownDescription: "The minimum number of shards.",
+				Required:            true,
+			},
+			"max_shard_count": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of shards.",
+				Required:            true,
+			},
+			"min_replication_factor": schema.Int64Attribute{
+				MarkdownDescription: "The minimum number of replicas per shard. Must be at least 1 when the cluster enforces multi-AZ placement. Set together with `max_replication_factor`; when both are omitted the replication factor is not scaled.",
+				Optional:            true,
+			},
+			"max_replication_factor": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The maximum number of replicas per shard, at most %d.", valkeyMaxReplicationFactor),
+				Optional:            true,
+			},
+			"target_memory_utilization": schema.Int64Attribute{
+				MarkdownDescription: "The memory utilization to maintain, as a percentage from 1 to 100. Shards are added when memory utilization is above the target and removed when it is well below it. At least one of `target_memory_utilization` and `target_cpu_utilization` is required.",
+				Optional:            true,
+			},
+			"target_cpu_utilization": schema.Int64Attribute{
+				MarkdownDescription: "The engine CPU utilization to maintain, as a percentage from 1 to 100. Replicas are added when CPU utilization is above the target, or shards when the replication factor is at its maximum or not scaled.",
+				Optional:            true,
+			},
+			"scale_out_cooldown": schema.StringAttribute{
+				MarkdownDescription: "How long to wait after a scaling change before adding shards or replicas, as a duration such as `5m`. Defaults to `5m`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("5m"),
+			},
+			"scale_in_cooldown": schema.StringAttribute{
+				MarkdownDescription: "How long to wait after a scaling change before removing shards or replicas, as a duration such as `15m`. Defaults to `15m`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("15m"),
+			},
+		},
+	}
+}
+
+func (r *ValkeyClusterAutoscalingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(MomentoClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected MomentoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.httpClient = clients.httpClient
+	r.httpEndpoint = clients.httpEndpoint
+	r.httpAuthToken = clients.httpAuthToken
+}
+
+func (r *ValkeyClusterAutoscalingResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// Values may be unknown during validation, so read only the attributes that are checked.
+	var minShardCount, maxShardCount, minReplicationFactor, maxReplicationFactor, targetMemoryUtilization, targetCpuUtilization types.Int64
+	var scaleOutCooldown, scaleInCooldown types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("min_shard_count"), &minShardCount)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("max_shard_count"), &maxShardCount)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("min_replication_factor"), &minReplicationFactor)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("max_replication_factor"), &maxReplicationFactor)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("target_memory_utilization"), &targetMemoryUtilization)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("target_cpu_utilization"), &targetCpuUtilization)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("scale_out_cooldown"), &scaleOutCooldown)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("scale_in_cooldown"), &scaleInCooldown)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if attrErr := validateValkeyAutoscalingBounds("shard_count", minShardCount, maxShardCount, 1, valkeyMaxNodes); attrErr != nil {
+		resp.Diagnostics.AddAttributeError(attrErr.AttributePath, attrErr.Summary, attrErr.Detail)
+	}
+
+	if minReplicationFactor.IsNull() != maxReplicationFactor.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("min_replication_factor"), "Invalid value", "min_replication_factor and max_replication_factor must be set together.")
+	} else if attrErr := validateValkeyAutoscalingBounds("replication_factor", minReplicationFactor, maxReplicationFactor, 0, valkeyMaxReplicationFactor); attrErr != nil {
+		resp.Diagnostics.AddAttributeError(attrErr.AttributePath, attrErr.Summary, attrErr.Detail)
+	}
+
+	if targetMemoryUtilization.IsNull() && targetCpuUtilization.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("target_memory_utilization"), "Missing value", "At least one of target_memory_utilization and target_cpu_utilization is required.")
+	}
+	for name, target := range map[string]types.Int64{"target_memory_utilization": targetMemoryUtilization, "target_cpu_utilization": targetCpuUtilization} {
+		if !target.IsNull() && !target.IsUnknown() && (target.ValueInt64() < 1 || target.ValueInt64() > 100) {
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Invalid value", fmt.Sprintf("%s must be between 1 and 100.", name))
+		}
+	}
+
+	for name, cooldown := range map[string]types.String{"scale_out_cooldown": scaleOutCooldown, "scale_in_cooldown": scaleInCooldown} {
+		if cooldown.IsNull() || cooldown.IsUnknown() {
+			continue
+		}
+		if d, err := time.ParseDuration(cooldown.ValueString()); err != nil || d < time.Minute {
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Invalid value", fmt.Sprintf("%s must be a duration of at least 1m, such as \"5m\", got %q.", name, cooldown.ValueString()))
+		}
+	}
+}
+
+// validateValkeyAutoscalingBounds checks that min_<name> and max_<name> are within the limits and in order.
+func validateValkeyAutoscalingBounds(name string, minValue types.Int64, maxValue types.Int64, lowest int64, highest int64) *AttributeError {
+	for _, bound := range []struct {
+		prefix string
+		value  types.Int64
+	}{{"min_", minValue}, {"max_", maxValue}} {
+		if bound.value.IsNull() || bound.value.IsUnknown() {
+			continue
+		}
+		if bound.value.ValueInt64() < lowest || bound.value.ValueInt64() > highest {
+			return &AttributeError{
+				AttributePath: path.Root(bound.prefix + name),
+				Summary:       "Invalid value",
+				Detail:        fmt.Sprintf("%s%s must be between %d and %d.", bound.prefix, name, lowest, highest),
+			}
+		}
+	}
+	if minValue.IsNull() || minValue.IsUnknown() || maxValue.IsNull() || maxValue.IsUnknown() {
+		return nil
+	}
+	if minValue.ValueInt64() > maxValue.ValueInt64() {
+		return &AttributeError{
+			AttributePath: path.Root("max_" + name),
+			Summary:       "Invalid value",
+			Detail:        fmt.Sprintf("max_%s (%d) must not be less than min_%s (%d).", name, maxValue.ValueInt64(), name, minValue.ValueInt64()),
+		}
+	}
+	return nil
+}
+
+func (r *ValkeyClusterAutoscalingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ValkeyClusterAutoscalingResourceModel
+
+	// Retrieve values from the plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.putAutoscalingPolicy(&plan); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create autoscaling policy for valkey cluster %s, got error: %s", plan.ClusterName.ValueString(), err))
+		return
+	}
+
+	plan.Id = types.StringValue(plan.ClusterName.ValueString())
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ValkeyClusterAutoscalingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ValkeyClusterAutoscalingResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := describeValkeyClusterAutoscalingPolicy(*r.httpClient, state.Id.ValueString(), r.httpEndpoint, r.httpAuthToken)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to describe autoscaling policy of valkey cluster, got error: %s", err))
+		return
+	}
+	if policy == nil {
+		resp.Diagnostics.AddWarning("Autoscaling Policy Not Found", fmt.Sprintf("Autoscaling policy of cluster \"%s\" not found, removing from state", state.Id.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ClusterName = types.StringValue(state.Id.ValueString())
+	state.MinShardCount = types.Int64Value(policy.MinShardCount)
+	state.MaxShardCount = types.Int64Value(policy.MaxShardCount)
+	state.MinReplicationFactor = optionalInt64Value(policy.MinReplicationFactor)
+	state.MaxReplicationFactor = optionalInt64Value(policy.MaxReplicationFactor)
+	state.TargetMemoryUtilization = optionalInt64Value(policy.TargetMemoryUtilization)
+	state.TargetCpuUtilization = optionalInt64Value(policy.TargetCpuUtilization)
+	state.ScaleOutCooldown = refreshCooldown(state.ScaleOutCooldown, policy.ScaleOutCooldownSeconds)
+	state.ScaleInCooldown = refreshCooldown(state.ScaleInCooldown, policy.ScaleInCooldownSeconds)
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ValkeyClusterAutoscalingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ValkeyClusterAutoscalingResourceModel
+
+	// Read Terraform planned state into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The request replaces the policy, so bounds removed from the configuration are no longer applied
+	if err := r.putAutoscalingPolicy(&plan); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update autoscaling policy for valkey cluster %s, got error: %s", plan.ClusterName.ValueString(), err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ValkeyClusterAutoscalingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ValkeyClusterAutoscalingResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := *r.httpClient
+	deleteRequest, err := http.NewRequest("DELETE", fmt.Sprintf("%s/ec-cluster/%s/autoscaling-policy", r.httpEndpoint, state.Id.ValueString()), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create HTTP request to delete autoscaling policy, got error: %s", err))
+		return
+	}
+	deleteRequest.Header.Set("Authorization", r.httpAuthToken)
+	httpResp, err := client.Do(deleteRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete autoscaling policy, got error: %s", err))
+		return
+	}
+	defer func() { _ = httpResp.Body.Close() }()
+	// A policy whose cluster is already gone does not need to be deleted
+	if httpResp.StatusCode >= 300 && httpResp.StatusCode != 404 {
+		body, _ := io.ReadAll(httpResp.Body)
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete autoscaling policy, got non-200 response: %s %s", httpResp.Status, string(body)))
+	}
+}
+
+func (r *ValkeyClusterAutoscalingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// PUT /ec-cluster/<cluster-name>/autoscaling-policy
+// Required fields: min_shard_count, max_shard_count, scale_out_cooldown_seconds, scale_in_cooldown_seconds
+// Optional fields: min_replication_factor, max_replication_factor, target_memory_utilization, target_cpu_utilization
+// Expected response: 2xx.
+func (r *ValkeyClusterAutoscalingResource) putAutoscalingPolicy(plan *ValkeyClusterAutoscalingResourceModel) error {
+	requestMap := map[string]interface{}{
+		"min_shard_count":            plan.MinShardCount.ValueInt64(),
+		"max_shard_count":            plan.MaxShardCount.ValueInt64(),
+		"scale_out_cooldown_seconds": cooldownSeconds(plan.ScaleOutCooldown),
+		"scale_in_cooldown_seconds":  cooldownSeconds(plan.ScaleInCooldown),
+	}
+	if !plan.MinReplicationFactor.IsNull() {
+		requestMap["min_replication_factor"] = plan.MinReplicationFactor.ValueInt64()
+		requestMap["max_replication_factor"] = plan.MaxReplicationFactor.ValueInt64()
+	}
+	if !plan.TargetMemoryUtilization.IsNull() {
+		requestMap["target_memory_utilization"] = plan.TargetMemoryUtilization.ValueInt64()
+	}
+	if !plan.TargetCpuUtilization.IsNull() {
+		requestMap["target_cpu_utilization"] = plan.TargetCpuUtilization.ValueInt64()
+	}
+
+	requestJson, err := json.Marshal(requestMap)
+	if err != nil {
+		return err
+	}
+
+	client := *r.httpClient
+	putRequest, err := http.NewRequest("PUT", fmt.Sprintf("%s/ec-cluster/%s/autoscaling-policy", r.httpEndpoint, plan.ClusterName.ValueString()), bytes.NewBuffer(requestJson))
+	if err != nil {
+		return err
+	}
+	putRequest.Header.Set("Authorization", r.httpAuthToken)
+	putRequest.Header.Set("Content-Type", "application/json")
+
+	httpResp, err := client.Do(putRequest)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = httpResp.Body.Close() }()
+	if httpResp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(httpResp.Body)
+		return fmt.Errorf("got non-200 response: %s %s", httpResp.Status, string(respBody))
+	}
+	return nil
+}
+
+// cooldownSeconds converts a validated cooldown duration to whole seconds.
+func cooldownSeconds(cooldown types.String) int64 {
+	d, _ := time.ParseDuration(cooldown.ValueString())
+	return int64(d / time.Second)
+}
+
+// refreshCooldown keeps the cooldown as written in the configuration when it is the same duration as the remote one,
+// so that "300s" and "5m" do not show up as a change.
+func refreshCooldown(cooldown types.String, remoteSeconds int64) types.String {
+	if !cooldown.IsNull() && cooldownSeconds(cooldown) == remoteSeconds {
+		return cooldown
+	}
+	return types.StringValue((time.Duration(remoteSeconds) * time.Second).String())
+}
+
+// optionalInt64Value returns null for a value the API omitted.
+func optionalInt64Value(value *int64) types.Int64 {
+	if value == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(*value)
+}
+
+type DescribeValkeyClusterAutoscalingPolicyResponseData struct {
+	MinShardCount           int64  `json:"min_shard_count"`
+	MaxShardCount           int64  `json:"max_shard_count"`
+	MinReplicationFactor    *int64 `json:"min_replication_factor"`
+	MaxReplicationFactor    *int64 `json:"max_replication_factor"`
+	TargetMemoryUtilization *int64 `json:"target_memory_utilization"`
+	TargetCpuUtilization    *int64 `json:"target_cpu_utilization"`
+	ScaleOutCooldownSeconds int64  `json:"scale_out_cooldown_seconds"`
+	ScaleInCooldownSeconds  int64  `json:"scale_in_cooldown_seconds"`
+}
+
+// GET /ec-cluster/<cluster-name>/autoscaling-policy
+// Returns nil without an error when the cluster has no autoscaling policy.
+func describeValkeyClusterAutoscalingPolicy(client http.Client, clusterName string, httpEndpoint string, httpAuthToken string) (*DescribeValkeyClusterAutoscalingPolicyResponseData, error) {
+	getRequest, err := http.NewRequest("GET", fmt.Sprintf("%s/ec-cluster/%s/autoscaling-policy", httpEndpoint, clusterName), nil)
+	if err != nil {
+		return nil, err
+	}
+	getRequest.Header.Set("Authorization", httpAuthToken)
+	getResp, err := client.Do(getRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = getResp.Body.Close() }()
+	// Do not error if 404 not found
+	if getResp.StatusCode == 404 {
+		return nil, nil
+	}
+	if getResp.StatusCode >= 300 {
+		body, _ := io.ReadAll(getResp.Body)
+		return nil, fmt.Errorf("unable to describe autoscaling policy, got non-200 response: %s %s", getResp.Status, string(body))
+	}
+
+	bodyBytes, err := io.ReadAll(getResp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %v", err)
+	}
+
+	var policy DescribeValkeyClusterAutoscalingPolicyResponseData
+	err = json.Unmarshal(bodyBytes, &policy)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling JSON: %v", err)
+	}
+	return &policy, nil
+}
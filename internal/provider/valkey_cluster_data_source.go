@@ -0,0 +1,278 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &ValkeyClusterDataSource{}
+	_ datasource.DataSourceWithConfigure = &ValkeyClusterDataSource{}
+)
+
+func NewValkeyClusterDataSource() datasource.DataSource {
+	return &ValkeyClusterDataSource{}
+}
+
+// ValkeyClusterDataSource defines the data source implementation.
+type ValkeyClusterDataSource struct {
+	httpClient    *http.Client
+	httpEndpoint  string
+	httpAuthToken string
+}
+
+// ValkeyClusterDataSourceModel describes the data source data model.
+type ValkeyClusterDataSourceModel struct {
+	Id                    types.String `tfsdk:"id"`
+	ClusterName           types.String `tfsdk:"cluster_name"`
+	NodeInstanceType      types.String `tfsdk:"node_instance_type"`
+	ShardCount            types.Int64  `tfsdk:"shard_count"`
+	ReplicationFactor     types.Int64  `tfsdk:"replication_factor"`
+	EnforceShardMultiAz   types.Bool   `tfsdk:"enforce_shard_multi_az"`
+	ShardPlacements       types.List   `tfsdk:"shard_placements"`
+	EngineVersion         types.String `tfsdk:"engine_version"`
+	ParameterGroupName    types.String `tfsdk:"parameter_group_name"`
+	MaintenanceWindow     types.String `tfsdk:"maintenance_window"`
+	AuthMode              types.String `tfsdk:"auth_mode"`
+	TransitEncryption     types.Bool   `tfsdk:"transit_encryption"`
+	Status                types.String `tfsdk:"status"`
+	Health                types.String `tfsdk:"health"`
+	ConfigurationEndpoint types.String `tfsdk:"configuration_endpoint"`
+	Port                  types.Int64  `tfsdk:"port"`
+	TlsRequired           types.Bool   `tfsdk:"tls_required"`
+	Shards                types.List   `tfsdk:"shards"`
+	CreatedAt             types.String `tfsdk:"created_at"`
+	Errors                types.List   `tfsdk:"errors"`
+}
+
+func (d *ValkeyClusterDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_valkey_cluster"
+}
+
+func (d *ValkeyClusterDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A Valkey Cluster, which may be managed in another Terraform state.",
+
+		Attributes: map[string]schema.Attribute{
+			// The testing framework requires an id attribute to be present in every data source and resource
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Valkey Cluster.",
+				Computed:            true,
+			},
+			"cluster_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the Valkey Cluster.",
+				Required:            true,
+			},
+			"node_instance_type": schema.StringAttribute{
+				MarkdownDescription: "The instance type for nodes in the Valkey Cluster.",
+				Computed:            true,
+			},
+			"shard_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of shards.",
+				Computed:            true,
+			},
+			"replication_factor": schema.Int64Attribute{
+				MarkdownDescription: "The number of replicas per shard.",
+				Computed:            true,
+			},
+			"enforce_shard_multi_az": schema.BoolAttribute{
+				MarkdownDescription: "Whether multi-AZ placement is enforced for shards.",
+				Computed:            true,
+			},
+			"shard_placements": schema.ListNestedAttribute{
+				MarkdownDescription: "The placement of each shard.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"index": schema.Int64Attribute{
+							MarkdownDescription: "The 0-based index of the shard.",
+							Computed:            true,
+						},
+						"availability_zone": schema.StringAttribute{
+							MarkdownDescription: "The availability zone for the primary node.",
+							Computed:            true,
+						},
+						"replica_availability_zones": schema.ListAttribute{
+							ElementType:         types.StringType,
+							MarkdownDescription: "The availability zones for replica nodes.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"engine_version": schema.StringAttribute{
+				MarkdownDescription: "The Valkey engine version, or the queued version when an upgrade is pending.",
+				Computed:            true,
+			},
+			"parameter_group_name": schema.StringAttribute{
+				MarkdownDescription: "The parameter group of the Valkey Cluster, or the queued parameter group when a change is pending.",
+				Computed:            true,
+			},
+			"maintenance_window": schema.StringAttribute{
+				MarkdownDescription: "The weekly maintenance window in UTC.",
+				Computed:            true,
+			},
+			"auth_mode": schema.StringAttribute{
+				MarkdownDescription: "How clients authenticate, `none` or `acl`.",
+				Computed:            true,
+			},
+			"transit_encryption": schema.BoolAttribute{
+				MarkdownDescription: "Whether connections to the Valkey Cluster are encrypted with TLS.",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the Valkey Cluster, e.g. `Active`.",
+				Computed:            true,
+			},
+			"health": schema.StringAttribute{
+				MarkdownDescription: "The health of the Valkey Cluster: `failed` when its creation failed, `degraded` when it reports `errors`, and `healthy` otherwise.",
+				Computed:            true,
+			},
+			"configuration_endpoint": schema.StringAttribute{
+				MarkdownDescription: "The cluster configuration (discovery) endpoint hostname that cluster-mode clients should connect to.",
+				Computed:            true,
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "The port of the configuration endpoint.",
+				Computed:            true,
+			},
+			"tls_required": schema.BoolAttribute{
+				MarkdownDescription: "Whether clients must connect to the Valkey Cluster using TLS.",
+				Computed:            true,
+			},
+			"shards": schema.ListNestedAttribute{
+				MarkdownDescription: "The node endpoints of each shard.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"index": schema.Int64Attribute{
+							MarkdownDescription: "The 0-based index of the shard.",
+							Computed:            true,
+						},
+						"nodes": schema.ListNestedAttribute{
+							MarkdownDescription: "The nodes of the shard.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"address": schema.StringAttribute{
+										MarkdownDescription: "The hostname of the node.",
+										Computed:            true,
+									},
+									"port": schema.Int64Attribute{
+										MarkdownDescription: "The port of the node.",
+										Computed:            true,
+									},
+									"role": schema.StringAttribute{
+										MarkdownDescription: "The role of the node, `primary` or `replica`.",
+										Computed:            true,
+									},
+									"availability_zone": schema.StringAttribute{
+										MarkdownDescription: "The availability zone of the node.",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The time the Valkey Cluster was created.",
+				Computed:            true,
+			},
+			"errors": schema.ListAttribute{
+				MarkdownDescription: "The errors last reported for the Valkey Cluster.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ValkeyClusterDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(MomentoClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected MomentoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.httpClient = clients.httpClient
+	d.httpEndpoint = clients.httpEndpoint
+	d.httpAuthToken = clients.httpAuthToken
+}
+
+func (d *ValkeyClusterDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ValkeyClusterDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Retrieve data from the API
+	clusterName := data.ClusterName.ValueString()
+	foundCluster, err := describeValkeyCluster(*d.httpClient, clusterName, d.httpEndpoint, d.httpAuthToken)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to describe valkey cluster, got error: %s", err))
+		return
+	}
+	if foundCluster == nil {
+		resp.Diagnostics.AddError("Cluster Not Found", fmt.Sprintf("Cluster with name \"%s\" not found", clusterName))
+		return
+	}
+
+	// The computed attributes are shared with the resource, which sets every unknown value from the response
+	cluster := ValkeyClusterResourceModel{
+		EffectiveShardPlacements: NewShardPlacementsValueUnknown(),
+		EngineVersion:            types.StringUnknown(),
+		ParameterGroupName:       types.StringUnknown(),
+		MaintenanceWindow:        types.StringUnknown(),
+		AuthMode:                 types.StringUnknown(),
+		TransitEncryption:        types.BoolUnknown(),
+	}
+	resp.Diagnostics.Append(setValkeyClusterComputedAttributes(ctx, &cluster, foundCluster)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(foundCluster.Name)
+	data.NodeInstanceType = types.StringValue(foundCluster.NodeInstanceType)
+	data.ShardCount = types.Int64Value(foundCluster.ShardCount)
+	data.ReplicationFactor = types.Int64Value(foundCluster.ReplicationFactor)
+	data.EnforceShardMultiAz = types.BoolValue(foundCluster.EnforceShardMultiAz)
+	data.ShardPlacements = cluster.EffectiveShardPlacements.ListValue
+	data.EngineVersion = cluster.EngineVersion
+	data.ParameterGroupName = cluster.ParameterGroupName
+	data.MaintenanceWindow = cluster.MaintenanceWindow
+	data.AuthMode = cluster.AuthMode
+	data.TransitEncryption = cluster.TransitEncryption
+	data.Status = cluster.Status
+	data.Health = cluster.Health
+	data.ConfigurationEndpoint = cluster.ConfigurationEndpoint
+	data.Port = cluster.Port
+	data.TlsRequired = cluster.TlsRequired
+	data.Shards = cluster.Shards
+	data.CreatedAt = cluster.CreatedAt
+	data.Errors = cluster.Errors
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
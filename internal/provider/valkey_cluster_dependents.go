@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// valkeyClusterDependent is an object store that uses a Valkey Cluster for caching.
+type valkeyClusterDependent struct {
+	Name string
+	// PerRouterOperationsPerSecond is the sum of the read and write throttling limits of each router, 0 when the
+	// object store is not throttled.
+	PerRouterOperationsPerSecond int64
+}
+
+// findValkeyClusterDependents returns the object stores that use the cluster, sorted by name.
+func findValkeyClusterDependents(client http.Client, clusterName string, httpEndpoint string, httpAuthToken string) ([]valkeyClusterDependent, error) {
+	objectStores, err := listObjectStores(client, httpEndpoint, httpAuthToken)
+	if err != nil {
+		return nil, err
+	}
+	return valkeyClusterDependentsOf(objectStores, clusterName), nil
+}
+
+// valkeyClusterDependentsOf returns the object stores that use the cluster, sorted by name.
+func valkeyClusterDependentsOf(objectStores []ObjectStoreData, clusterName string) []valkeyClusterDependent {
+	var dependents []valkeyClusterDependent
+	for _, objectStore := range objectStores {
+		if objectStore.CacheConfig.ValkeyCluster.ClusterName != clusterName {
+			continue
+		}
+		dependent := valkeyClusterDependent{Name: objectStore.Name}
+		if limits := objectStore.ThrottlingLimits; limits != nil {
+			if limits.ReadOperationsPerSecond != nil {
+				dependent.PerRouterOperationsPerSecond += *limits.ReadOperationsPerSecond
+			}
+			if limits.WriteOperationsPerSecond != nil {
+				dependent.PerRouterOperationsPerSecond += *limits.WriteOperationsPerSecond
+			}
+		}
+		dependents = append(dependents, dependent)
+	}
+	sort.Slice(dependents, func(i, j int) bool { return dependents[i].Name < dependents[j].Name })
+	return dependents
+}
+
+func valkeyClusterDependentNames(dependents []valkeyClusterDependent) string {
+	names := make([]string, 0, len(dependents))
+	for _, dependent := range dependents {
+		names = append(names, fmt.Sprintf("%q", dependent.Name))
+	}
+	return strings.Join(names, ", ")
+}
+
+// valkeyClusterOperationsCapacity estimates the operations per second a cluster layout serves as valkeyOpsPerVcpu for
+// every vCPU of every node, primaries and replicas alike, which is the conservative estimate the
+// momento_valkey_capacity_plan data source uses. The estimate does not account for the workload, so it is only used
+// to warn. It returns false for node types that are not in the catalog.
+func valkeyClusterOperationsCapacity(nodeInstanceType string, shardCount int64, replicationFactor int64) (int64, bool) {
+	nodeType := findValkeyNodeType(nodeInstanceType)
+	if nodeType == nil {
+		return 0, false
+	}
+	return shardCount * (1 + replicationFactor) * nodeType.Vcpus * valkeyOpsPerVcpu, true
+}
+
+// checkValkeyClusterCapacityForDependents checks that an update which reduces the estimated capacity of the cluster
+// still serves the throttling limits of the object stores that use it. Updates that do not reduce the capacity, and
+// layouts whose capacity cannot be estimated, are not checked, so the object stores are only listed when needed.
+func checkValkeyClusterCapacityForDependents(client http.Client, httpEndpoint string, httpAuthToken string, currentState *ValkeyClusterResourceModel, plan *ValkeyClusterResourceModel) (*AttributeError, error) {
+	if _, reduced := reducedValkeyClusterCapacity(currentState, plan); !reduced {
+		return nil, nil
+	}
+	dependents, err := findValkeyClusterDependents(client, currentState.ClusterName.ValueString(), httpEndpoint, httpAuthToken)
+	if err != nil {
+		return nil, err
+	}
+	if _, perRouterRequired := throttledValkeyClusterDependents(dependents); perRouterRequired == 0 {
+		return nil, nil
+	}
+	routerCount, err := fetchRouterCount(client, httpEndpoint, httpAuthToken)
+	if err != nil {
+		return nil, err
+	}
+	return valkeyClusterCapacityError(currentState, plan, dependents, routerCount), nil
+}
+
+// reducedValkeyClusterCapacity returns the estimated capacity of the planned layout, and whether it is below the
+// estimated capacity of the current layout.
+func reducedValkeyClusterCapacity(currentState *ValkeyClusterResourceModel, plan *ValkeyClusterResourceModel) (int64, bool) {
+	currentCapacity, ok := valkeyClusterOperationsCapacity(currentState.NodeInstanceType.ValueString(), currentState.ShardCount.ValueInt64(), currentState.ReplicationFactor.ValueInt64())
+	if !ok {
+		return 0, false
+	}
+	plannedCapacity, ok := valkeyClusterOperationsCapacity(plan.NodeInstanceType.ValueString(), plan.ShardCount.ValueInt64(), plan.ReplicationFactor.ValueInt64())
+	if !ok {
+		return 0, false
+	}
+	return plannedCapacity, plannedCapacity < currentCapacity
+}
+
+// throttledValkeyClusterDependents returns the dependents with throttling limits and the sum of their per-router
+// operations per second. Dependents without limits are not counted, since what they require is not known.
+func throttledValkeyClusterDependents(dependents []valkeyClusterDependent) ([]valkeyClusterDependent, int64) {
+	var throttled []valkeyClusterDependent
+	var perRouterRequired int64
+	for _, dependent := range dependents {
+		if dependent.PerRouterOperationsPerSecond > 0 {
+			throttled = append(throttled, dependent)
+			perRouterRequired += dependent.PerRouterOperationsPerSecond
+		}
+	}
+	return throttled, perRouterRequired
+}
+
+// valkeyClusterCapacityError returns an error when an update reduces the estimated capacity of the cluster below the
+// operations per second the throttling limits of its dependents allow on all routers, and nil otherwise.
+func valkeyClusterCapacityError(currentState *ValkeyClusterResourceModel, plan *ValkeyClusterResourceModel, dependents []valkeyClusterDependent, routerCount int64) *AttributeError {
+	plannedCapacity, reduced := reducedValkeyClusterCapacity(currentState, plan)
+	if !reduced {
+		return nil
+	}
+	throttled, perRouterRequired := throttledValkeyClusterDependents(dependents)
+	required := perRouterRequired * routerCount
+	if required == 0 || plannedCapacity >= required {
+		return nil
+	}
+
+	attributePath := path.Root("shard_count")
+	if plan.NodeInstanceType.ValueString() != currentState.NodeInstanceType.ValueString() {
+		attributePath = path.Root("node_instance_type")
+	} else if plan.ReplicationFactor.ValueInt64() < currentState.ReplicationFactor.ValueInt64() {
+		attributePath = path.Root("replication_factor")
+	}
+	return &AttributeError{
+		AttributePath: attributePath,
+		Summary:       "Insufficient Capacity For Object Stores",
+		Detail: fmt.Sprintf("The update reduces the estimated capacity of cluster %q to %d operations per second, below the %d operations per second allowed by the throttling limits of object stores %s. The capacity is estimated at %d operations per second per vCPU, so a cluster may serve more or less depending on the workload. Lower the throttling limits of the object stores, keep a larger cluster if they need the capacity, or set allow_capacity_reduction to make the update anyway.",
+			currentState.ClusterName.ValueString(), plannedCapacity, required, valkeyClusterDependentNames(throttled), valkeyOpsPerVcpu),
+	}
+}
+
+// appendValkeyClusterCapacityDiagnostic reports a lack of capacity for the object stores using a cluster as an error,
+// or as a warning when allow_capacity_reduction is set.
+func appendValkeyClusterCapacityDiagnostic(diags *diag.Diagnostics, capacityErr *AttributeError, allowReduction bool) {
+	if allowReduction {
+		diags.AddAttributeWarning(capacityErr.AttributePath, capacityErr.Summary, capacityErr.Detail)
+		return
+	}
+	diags.AddAttributeError(capacityErr.AttributePath, capacityErr.Summary, capacityErr.Detail)
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testObjectStore(name string, clusterName string, limits *ObjectStoreThrottlingLimits) ObjectStoreData {
+	objectStore := ObjectStoreData{Name: name, ThrottlingLimits: limits}
+	objectStore.CacheConfig.ValkeyCluster.ClusterName = clusterName
+	return objectStore
+}
+
+func testInt64Pointer(value int64) *int64 {
+	return &value
+}
+
+func TestValkeyClusterDependentsOf(t *testing.T) {
+	objectStores := []ObjectStoreData{
+		testObjectStore("zeta", "cluster", &ObjectStoreThrottlingLimits{ReadOperationsPerSecond: testInt64Pointer(100), WriteOperationsPerSecond: testInt64Pointer(50), ReadBytesPerSecond: testInt64Pointer(1000)}),
+		testObjectStore("other", "other-cluster", &ObjectStoreThrottlingLimits{ReadOperationsPerSecond: testInt64Pointer(100)}),
+		testObjectStore("alpha", "cluster", nil),
+		testObjectStore("beta", "cluster", &ObjectStoreThrottlingLimits{WriteOperationsPerSecond: testInt64Pointer(20)}),
+	}
+	want := []valkeyClusterDependent{
+		{Name: "alpha"},
+		{Name: "beta", PerRouterOperationsPerSecond: 20},
+		{Name: "zeta", PerRouterOperationsPerSecond: 150},
+	}
+	if got := valkeyClusterDependentsOf(objectStores, "cluster"); !reflect.DeepEqual(got, want) {
+		t.Errorf("valkeyClusterDependentsOf() = %+v, want %+v", got, want)
+	}
+	if got := valkeyClusterDependentsOf(objectStores, "unused"); len(got) != 0 {
+		t.Errorf("valkeyClusterDependentsOf() = %+v, want none", got)
+	}
+	if got := valkeyClusterDependentNames(want); got != `"alpha", "beta", "zeta"` {
+		t.Errorf("valkeyClusterDependentNames() = %s", got)
+	}
+}
+
+func TestValkeyClusterOperationsCapacity(t *testing.T) {
+	// 2 shards of a primary and a replica, with 2 vCPUs each
+	if capacity, ok := valkeyClusterOperationsCapacity("cache.r7g.large", 2, 1); !ok || capacity != 2*2*2*valkeyOpsPerVcpu {
+		t.Errorf("valkeyClusterOperationsCapacity() = %d, %v, want %d", capacity, ok, 2*2*2*valkeyOpsPerVcpu)
+	}
+	if _, ok := valkeyClusterOperationsCapacity("cache.unknown", 2, 1); ok {
+		t.Error("valkeyClusterOperationsCapacity() estimated the capacity of an unknown node type")
+	}
+}
+
+func testValkeyClusterLayout(nodeInstanceType string, shardCount int64, replicationFactor int64) *ValkeyClusterResourceModel {
+	return &ValkeyClusterResourceModel{
+		ClusterName:       types.StringValue("cluster"),
+		NodeInstanceType:  types.StringValue(nodeInstanceType),
+		ShardCount:        types.Int64Value(shardCount),
+		ReplicationFactor: types.Int64Value(replicationFactor),
+	}
+}
+
+func TestValkeyClusterCapacityError(t *testing.T) {
+	// 4 shards of a primary and a replica with 2 vCPUs each are estimated at 400000 operations per second
+	current := testValkeyClusterLayout("cache.r7g.large", 4, 1)
+	dependents := []valkeyClusterDependent{{Name: "throttled", PerRouterOperationsPerSecond: 30000}, {Name: "unthrottled"}}
+	tests := []struct {
+		name        string
+		current     *ValkeyClusterResourceModel
+		plan        *ValkeyClusterResourceModel
+		dependents  []valkeyClusterDependent
+		routerCount int64
+		wantPath    *path.Path
+	}{
+		{name: "fewer shards below the limits", plan: testValkeyClusterLayout("cache.r7g.large", 2, 1), dependents: dependents, routerCount: 10, wantPath: pathPointer(path.Root("shard_count"))},
+		{name: "fewer replicas below the limits", plan: testValkeyClusterLayout("cache.r7g.large", 4, 0), dependents: dependents, routerCount: 10, wantPath: pathPointer(path.Root("replication_factor"))},
+		{name: "smaller nodes below the limits", current: testValkeyClusterLayout("cache.r7g.xlarge", 2, 1), plan: testValkeyClusterLayout("cache.r7g.large", 2, 1), dependents: dependents, routerCount: 10, wantPath: pathPointer(path.Root("node_instance_type"))},
+		{name: "fewer shards within the limits", plan: testValkeyClusterLayout("cache.r7g.large", 2, 1), dependents: dependents, routerCount: 5},
+		{name: "limits exactly served", plan: testValkeyClusterLayout("cache.r7g.large", 2, 1), dependents: []valkeyClusterDependent{{Name: "throttled", PerRouterOperationsPerSecond: 20000}}, routerCount: 10},
+		{name: "capacity not reduced", plan: testValkeyClusterLayout("cache.r7g.xlarge", 4, 1), dependents: dependents, routerCount: 100},
+		{name: "unknown node type", plan: testValkeyClusterLayout("cache.unknown", 1, 0), dependents: dependents, routerCount: 100},
+		{name: "no throttled dependents", plan: testValkeyClusterLayout("cache.r7g.large", 1, 0), dependents: []valkeyClusterDependent{{Name: "unthrottled"}}, routerCount: 100},
+		{name: "no dependents", plan: testValkeyClusterLayout("cache.r7g.large", 1, 0), routerCount: 100},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			currentLayout := current
+			if tt.current != nil {
+				currentLayout = tt.current
+			}
+			attrErr := valkeyClusterCapacityError(currentLayout, tt.plan, tt.dependents, tt.routerCount)
+			if tt.wantPath == nil {
+				if attrErr != nil {
+					t.Errorf("unexpected error: %s", attrErr.Detail)
+				}
+				return
+			}
+			if attrErr == nil {
+				t.Fatal("expected an error")
+			}
+			if !attrErr.AttributePath.Equal(*tt.wantPath) {
+				t.Errorf("error on %s, want %s", attrErr.AttributePath, *tt.wantPath)
+			}
+			if !strings.Contains(attrErr.Detail, `"throttled"`) || strings.Contains(attrErr.Detail, `"unthrottled"`) {
+				t.Errorf("error detail %q does not list only the throttled object stores", attrErr.Detail)
+			}
+			if !strings.Contains(attrErr.Detail, "300000 operations per second allowed") {
+				t.Errorf("error detail %q does not report the limits on all routers", attrErr.Detail)
+			}
+		})
+	}
+}
+
+func pathPointer(p path.Path) *path.Path {
+	return &p
+}
+
+func TestAppendValkeyClusterCapacityDiagnostic(t *testing.T) {
+	capacityErr := &AttributeError{AttributePath: path.Root("shard_count"), Summary: "Insufficient Capacity For Object Stores", Detail: "detail"}
+
+	var diags diag.Diagnostics
+	appendValkeyClusterCapacityDiagnostic(&diags, capacityErr, false)
+	if diags.ErrorsCount() != 1 || diags.WarningsCount() != 0 {
+		t.Errorf("diagnostics = %v, want an error", diags)
+	}
+
+	diags = nil
+	appendValkeyClusterCapacityDiagnostic(&diags, capacityErr, true)
+	if diags.ErrorsCount() != 0 || diags.WarningsCount() != 1 {
+		t.Errorf("diagnostics = %v, want a warning when allow_capacity_reduction is set", diags)
+	}
+}
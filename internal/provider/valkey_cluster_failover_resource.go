@@ -0,0 +1,334 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &ValkeyClusterFailoverResource{}
+	_ resource.ResourceWithConfigure      = &ValkeyClusterFailoverResource{}
+	_ resource.ResourceWithValidateConfig = &ValkeyClusterFailoverResource{}
+)
+
+func NewValkeyClusterFailoverResource() resource.Resource {
+	return &ValkeyClusterFailoverResource{}
+}
+
+// ValkeyClusterFailoverResource defines the resource implementation.
+type ValkeyClusterFailoverResource struct {
+	httpClient    *http.Client
+	httpEndpoint  string
+	httpAuthToken string
+}
+
+// ValkeyClusterFailoverResourceModel describes the resource data model.
+type ValkeyClusterFailoverResourceModel struct {
+	Id                      types.String   `tfsdk:"id"`
+	ClusterName             types.String   `tfsdk:"cluster_name"`
+	ShardIndex              types.Int64    `tfsdk:"shard_index"`
+	ReplicaAddress          types.String   `tfsdk:"replica_address"`
+	Triggers                types.Map      `tfsdk:"triggers"`
+	PollInterval            types.String   `tfsdk:"poll_interval"`
+	PrimaryAddress          types.String   `tfsdk:"primary_address"`
+	PrimaryAvailabilityZone types.String   `tfsdk:"primary_availability_zone"`
+	Timeouts                timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *ValkeyClusterFailoverResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_valkey_cluster_failover"
+}
+
+func (r *ValkeyClusterFailoverResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fails over a shard of a Valkey Cluster by promoting one of its replicas to primary, and waits for the cluster to become active again. The failover is performed when the resource is created, and again whenever it is replaced, e.g. by changing `triggers`. Destroying the resource does not affect the cluster.",
+
+		Attributes: map[string]schema.Attribute{
+			// The testing framework requires an id attribute to be present in every data source and resource
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the failover, in the format `<cluster_name>/<shard_index>`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the Valkey Cluster.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"shard_index": schema.Int64Attribute{
+				MarkdownDescription: "The 0-based index of the shard to fail over.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"replica_address": schema.StringAttribute{
+				MarkdownDescription: "The address of the replica to promote, as listed in the `shards` of the cluster. Defaults to the replica Momento considers best suited, e.g. the one with the least replication lag.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that cause the failover to be performed again when they change.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"poll_interval": schema.StringAttribute{
+				MarkdownDescription: "How long to wait between checks of the cluster status after the failover was requested, as a duration such as `15s`. The interval backs off while the status does not change, up to 2 minutes or the configured interval if longer. Defaults to `30s`.",
+				Optional:            true,
+			},
+			"primary_address": schema.StringAttribute{
+				MarkdownDescription: "The address of the primary of the shard after the failover.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"primary_availability_zone": schema.StringAttribute{
+				MarkdownDescription: "The availability zone of the primary of the shard after the failover.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *ValkeyClusterFailoverResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(MomentoClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected MomentoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.httpClient = clients.httpClient
+	r.httpEndpoint = clients.httpEndpoint
+	r.httpAuthToken = clients.httpAuthToken
+}
+
+func (r *ValkeyClusterFailoverResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var shardIndex types.Int64
+	var pollInterval types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("shard_index"), &shardIndex)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("poll_interval"), &pollInterval)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !shardIndex.IsNull() && !shardIndex.IsUnknown() && shardIndex.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("shard_index"), "Invalid value", "shard_index must not be negative.")
+	}
+	if attrErr := validatePollInterval(pollInterval); attrErr != nil {
+		resp.Diagnostics.AddAttributeError(attrErr.AttributePath, attrErr.Summary, attrErr.Detail)
+	}
+}
+
+func (r *ValkeyClusterFailoverResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ValkeyClusterFailoverResourceModel
+
+	// Retrieve values from the plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, 60*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	clusterName := plan.ClusterName.ValueString()
+	shardIndex := plan.ShardIndex.ValueInt64()
+
+	// Check the shard and replica up front, so that a typo does not surface as an opaque API error
+	foundCluster, err := describeValkeyCluster(*r.httpClient, clusterName, r.httpEndpoint, r.httpAuthToken)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to describe valkey cluster, got error: %s", err))
+		return
+	}
+	if foundCluster == nil {
+		resp.Diagnostics.AddError("Cluster Not Found", fmt.Sprintf("Cluster with name \"%s\" not found", clusterName))
+		return
+	}
+	if shardIndex >= foundCluster.ShardCount {
+		resp.Diagnostics.AddAttributeError(path.Root("shard_index"), "Shard Not Found", fmt.Sprintf("Cluster \"%s\" has %d shards, there is no shard with index %d.", clusterName, foundCluster.ShardCount, shardIndex))
+		return
+	}
+	if !plan.ReplicaAddress.IsNull() && !isValkeyClusterReplica(foundCluster, shardIndex, plan.ReplicaAddress.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("replica_address"), "Replica Not Found", fmt.Sprintf("Shard %d of cluster \"%s\" has no replica with address %s.", shardIndex, clusterName, plan.ReplicaAddress.ValueString()))
+		return
+	}
+
+	var replicaAddress *string
+	if !plan.ReplicaAddress.IsNull() {
+		replicaAddress = plan.ReplicaAddress.ValueStringPointer()
+	}
+	tflog.Info(ctx, "Failing over valkey cluster shard", map[string]interface{}{"cluster_name": clusterName, "shard_index": shardIndex})
+	if err := failoverValkeyClusterShard(*r.httpClient, clusterName, shardIndex, replicaAddress, r.httpEndpoint, r.httpAuthToken); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fail over shard %d of valkey cluster, got error: %s", shardIndex, err))
+		return
+	}
+
+	foundCluster, err = waitUntilValkeyClusterActive(ctx, *r.httpClient, clusterName, r.httpEndpoint, r.httpAuthToken, pollIntervalFromConfig(plan.PollInterval))
+	if err != nil {
+		resp.Diagnostics.AddError("Cluster Not Ready", fmt.Sprintf("Error waiting for cluster \"%s\" after failing over shard %d: %s", clusterName, shardIndex, err))
+		return
+	}
+	if foundCluster.Status != "Active" {
+		resp.Diagnostics.AddError("Cluster Not Ready", fmt.Sprintf("Cluster \"%s\" reached status %s after failing over shard %d, with errors: %v", clusterName, foundCluster.Status, shardIndex, foundCluster.Errors))
+		return
+	}
+
+	plan.Id = types.StringValue(fmt.Sprintf("%s/%d", clusterName, shardIndex))
+	plan.PrimaryAddress = types.StringNull()
+	plan.PrimaryAvailabilityZone = types.StringNull()
+	for _, shard := range foundCluster.Shards {
+		if shard.ShardIndex != shardIndex {
+			continue
+		}
+		for _, node := range shard.Nodes {
+			if node.Role == "primary" {
+				plan.PrimaryAddress = types.StringValue(node.Address)
+				plan.PrimaryAvailabilityZone = types.StringValue(node.AvailabilityZone)
+			}
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ValkeyClusterFailoverResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ValkeyClusterFailoverResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The attributes record the outcome of the failover, so they are not refreshed; a failover for a cluster that is
+	// gone is removed
+	foundCluster, err := describeValkeyCluster(*r.httpClient, state.ClusterName.ValueString(), r.httpEndpoint, r.httpAuthToken)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to describe valkey cluster, got error: %s", err))
+		return
+	}
+	if foundCluster == nil {
+		resp.Diagnostics.AddWarning("Cluster Not Found", fmt.Sprintf("Cluster with name \"%s\" not found, removing failover from state", state.ClusterName.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+}
+
+func (r *ValkeyClusterFailoverResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ValkeyClusterFailoverResourceModel
+
+	// Only poll_interval and timeouts can change without replacement, and they only affect the next failover
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ValkeyClusterFailoverResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Destroying the failover does not affect the cluster
+}
+
+// isValkeyClusterReplica reports whether the node with the address is a replica in the shard.
+func isValkeyClusterReplica(cluster *DescribeValkeyClustersResponseData, shardIndex int64, address string) bool {
+	for _, shard := range cluster.Shards {
+		if shard.ShardIndex != shardIndex {
+			continue
+		}
+		for _, node := range shard.Nodes {
+			if node.Address == address && node.Role == "replica" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// POST /ec-cluster/<cluster-name>/failover
+// Required fields: shard_index
+// Optional fields: replica_address
+// Expected response: 202 Accepted.
+func failoverValkeyClusterShard(client http.Client, clusterName string, shardIndex int64, replicaAddress *string, httpEndpoint string, httpAuthToken string) error {
+	requestMap := map[string]interface{}{
+		"shard_index": shardIndex,
+	}
+	if replicaAddress != nil {
+		requestMap["replica_address"] = *replicaAddress
+	}
+	requestJson, err := json.Marshal(requestMap)
+	if err != nil {
+		return err
+	}
+
+	postRequest, err := http.NewRequest("POST", fmt.Sprintf("%s/ec-cluster/%s/failover", httpEndpoint, clusterName), bytes.NewBuffer(requestJson))
+	if err != nil {
+		return err
+	}
+	postRequest.Header.Set("Authorization", httpAuthToken)
+	postRequest.Header.Set("Content-Type", "application/json")
+
+	httpResp, err := client.Do(postRequest)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = httpResp.Body.Close() }()
+	if httpResp.StatusCode != 202 {
+		respBody, _ := io.ReadAll(httpResp.Body)
+		return fmt.Errorf("unable to fail over shard, got non-202 response: %s %s", httpResp.Status, string(respBody))
+	}
+	return nil
+}
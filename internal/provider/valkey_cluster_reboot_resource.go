@@ -0,0 +1,305 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &ValkeyClusterRebootResource{}
+	_ resource.ResourceWithConfigure      = &ValkeyClusterRebootResource{}
+	_ resource.ResourceWithValidateConfig = &ValkeyClusterRebootResource{}
+)
+
+func NewValkeyClusterRebootResource() resource.Resource {
+	return &ValkeyClusterRebootResource{}
+}
+
+// ValkeyClusterRebootResource defines the resource implementation.
+type ValkeyClusterRebootResource struct {
+	httpClient    *http.Client
+	httpEndpoint  string
+	httpAuthToken string
+}
+
+// ValkeyClusterRebootResourceModel describes the resource data model.
+type ValkeyClusterRebootResourceModel struct {
+	Id           types.String   `tfsdk:"id"`
+	ClusterName  types.String   `tfsdk:"cluster_name"`
+	ShardIndexes types.List     `tfsdk:"shard_indexes"`
+	Triggers     types.Map      `tfsdk:"triggers"`
+	PollInterval types.String   `tfsdk:"poll_interval"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *ValkeyClusterRebootResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_valkey_cluster_reboot"
+}
+
+func (r *ValkeyClusterRebootResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reboots the nodes of a Valkey Cluster one shard at a time, waiting for the cluster to become active again before rebooting the next shard. The reboot is performed when the resource is created, and again whenever it is replaced, e.g. by changing `triggers`. Destroying the resource does not affect the cluster.",
+
+		Attributes: map[string]schema.Attribute{
+			// The testing framework requires an id attribute to be present in every data source and resource
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the reboot, which is the name of the Valkey Cluster.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the Valkey Cluster.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"shard_indexes": schema.ListAttribute{
+				MarkdownDescription: "The 0-based indexes of the shards to reboot, in the order they are rebooted. Defaults to every shard of the cluster, in index order.",
+				ElementType:         types.Int64Type,
+				Optional:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that cause the reboot to be performed again when they change.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"poll_interval": schema.StringAttribute{
+				MarkdownDescription: "How long to wait between checks of the cluster status after each shard is rebooted, as a duration such as `15s`. The interval backs off while the status does not change, up to 2 minutes or the configured interval if longer. Defaults to `30s`.",
+				Optional:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *ValkeyClusterRebootResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(MomentoClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected MomentoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.httpClient = clients.httpClient
+	r.httpEndpoint = clients.httpEndpoint
+	r.httpAuthToken = clients.httpAuthToken
+}
+
+func (r *ValkeyClusterRebootResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var shardIndexes types.List
+	var pollInterval types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("shard_indexes"), &shardIndexes)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("poll_interval"), &pollInterval)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if attrErr := validatePollInterval(pollInterval); attrErr != nil {
+		resp.Diagnostics.AddAttributeError(attrErr.AttributePath, attrErr.Summary, attrErr.Detail)
+	}
+
+	if shardIndexes.IsNull() || shardIndexes.IsUnknown() {
+		return
+	}
+	var indexes []types.Int64
+	resp.Diagnostics.Append(shardIndexes.ElementsAs(ctx, &indexes, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(indexes) == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("shard_indexes"), "Invalid value", "shard_indexes must not be empty, omit it to reboot every shard.")
+		return
+	}
+	seen := make(map[int64]bool, len(indexes))
+	for i, index := range indexes {
+		if index.IsNull() || index.IsUnknown() {
+			continue
+		}
+		if index.ValueInt64() < 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("shard_indexes").AtListIndex(i), "Invalid value", "Shard indexes must not be negative.")
+		} else if seen[index.ValueInt64()] {
+			resp.Diagnostics.AddAttributeError(path.Root("shard_indexes").AtListIndex(i), "Invalid value", fmt.Sprintf("Shard %d is listed more than once.", index.ValueInt64()))
+		}
+		seen[index.ValueInt64()] = true
+	}
+}
+
+func (r *ValkeyClusterRebootResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ValkeyClusterRebootResourceModel
+
+	// Retrieve values from the plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, 120*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	clusterName := plan.ClusterName.ValueString()
+	foundCluster, err := describeValkeyCluster(*r.httpClient, clusterName, r.httpEndpoint, r.httpAuthToken)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to describe valkey cluster, got error: %s", err))
+		return
+	}
+	if foundCluster == nil {
+		resp.Diagnostics.AddError("Cluster Not Found", fmt.Sprintf("Cluster with name \"%s\" not found", clusterName))
+		return
+	}
+
+	var shardIndexes []int64
+	if plan.ShardIndexes.IsNull() {
+		for i := int64(0); i < foundCluster.ShardCount; i++ {
+			shardIndexes = append(shardIndexes, i)
+		}
+	} else {
+		resp.Diagnostics.Append(plan.ShardIndexes.ElementsAs(ctx, &shardIndexes, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for _, shardIndex := range shardIndexes {
+			if shardIndex >= foundCluster.ShardCount {
+				resp.Diagnostics.AddAttributeError(path.Root("shard_indexes"), "Shard Not Found", fmt.Sprintf("Cluster \"%s\" has %d shards, there is no shard with index %d.", clusterName, foundCluster.ShardCount, shardIndex))
+				return
+			}
+		}
+	}
+
+	// Reboot one shard at a time, so that the other shards keep serving while a shard restarts
+	pollInterval := pollIntervalFromConfig(plan.PollInterval)
+	for i, shardIndex := range shardIndexes {
+		tflog.Info(ctx, "Rebooting valkey cluster shard", map[string]interface{}{"cluster_name": clusterName, "shard_index": shardIndex})
+		if err := rebootValkeyClusterShard(*r.httpClient, clusterName, shardIndex, r.httpEndpoint, r.httpAuthToken); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reboot shard %d of valkey cluster after rebooting shards %v, got error: %s", shardIndex, shardIndexes[:i], err))
+			return
+		}
+		foundCluster, err = waitUntilValkeyClusterActive(ctx, *r.httpClient, clusterName, r.httpEndpoint, r.httpAuthToken, pollInterval)
+		if err != nil {
+			resp.Diagnostics.AddError("Cluster Not Ready", fmt.Sprintf("Error waiting for cluster \"%s\" after rebooting shard %d: %s", clusterName, shardIndex, err))
+			return
+		}
+		if foundCluster.Status != "Active" {
+			resp.Diagnostics.AddError("Cluster Not Ready", fmt.Sprintf("Cluster \"%s\" reached status %s after rebooting shard %d, with errors: %v", clusterName, foundCluster.Status, shardIndex, foundCluster.Errors))
+			return
+		}
+	}
+
+	plan.Id = types.StringValue(clusterName)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ValkeyClusterRebootResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ValkeyClusterRebootResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A reboot for a cluster that is gone is removed
+	foundCluster, err := describeValkeyCluster(*r.httpClient, state.ClusterName.ValueString(), r.httpEndpoint, r.httpAuthToken)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to describe valkey cluster, got error: %s", err))
+		return
+	}
+	if foundCluster == nil {
+		resp.Diagnostics.AddWarning("Cluster Not Found", fmt.Sprintf("Cluster with name \"%s\" not found, removing reboot from state", state.ClusterName.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+}
+
+func (r *ValkeyClusterRebootResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ValkeyClusterRebootResourceModel
+
+	// Only poll_interval and timeouts can change without replacement, and they only affect the next reboot
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ValkeyClusterRebootResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Destroying the reboot does not affect the cluster
+}
+
+// POST /ec-cluster/<cluster-name>/reboot
+// Required fields: shard_index
+// Expected response: 202 Accepted.
+func rebootValkeyClusterShard(client http.Client, clusterName string, shardIndex int64, httpEndpoint string, httpAuthToken string) error {
+	requestJson, err := json.Marshal(map[string]interface{}{
+		"shard_index": shardIndex,
+	})
+	if err != nil {
+		return err
+	}
+
+	postRequest, err := http.NewRequest("POST", fmt.Sprintf("%s/ec-cluster/%s/reboot", httpEndpoint, clusterName), bytes.NewBuffer(requestJson))
+	if err != nil {
+		return err
+	}
+	postRequest.Header.Set("Authorization", httpAuthToken)
+	postRequest.Header.Set("Content-Type", "application/json")
+
+	httpResp, err := client.Do(postRequest)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = httpResp.Body.Close() }()
+	if httpResp.StatusCode != 202 {
+		respBody, _ := io.ReadAll(httpResp.Body)
+		return fmt.Errorf("unable to reboot shard, got non-202 response: %s %s", httpResp.Status, string(respBody))
+	}
+	return nil
+}
@@ -7,26 +7,37 @@ import (
 	"fmt"
 	"io"
 	"net/http"
-	"reflect"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource              = &ValkeyClusterResource{}
-	_ resource.ResourceWithConfigure = &ValkeyClusterResource{}
+	_ resource.Resource                   = &ValkeyClusterResource{}
+	_ resource.ResourceWithConfigure      = &ValkeyClusterResource{}
+	_ resource.ResourceWithImportState    = &ValkeyClusterResource{}
+	_ resource.ResourceWithValidateConfig = &ValkeyClusterResource{}
+	_ resource.ResourceWithModifyPlan     = &ValkeyClusterResource{}
 )
 
 func NewValkeyClusterResource() resource.Resource {
@@ -38,6 +49,7 @@ type ValkeyClusterResource struct {
 	httpClient    *http.Client
 	httpEndpoint  string
 	httpAuthToken string
+	defaultTags   map[string]string
 }
 
 type ShardPlacementModel struct {
@@ -46,16 +58,82 @@ type ShardPlacementModel struct {
 	ReplicaAvailabilityZones []types.String `tfsdk:"replica_availability_zones"`
 }
 
+type ValkeyClusterShardModel struct {
+	Index types.Int64              `tfsdk:"index"`
+	Nodes []ValkeyClusterNodeModel `tfsdk:"nodes"`
+}
+
+type ValkeyClusterNodeModel struct {
+	Address          types.String `tfsdk:"address"`
+	Port             types.Int64  `tfsdk:"port"`
+	Role             types.String `tfsdk:"role"`
+	AvailabilityZone types.String `tfsdk:"availability_zone"`
+}
+
+var valkeyClusterShardPlacementAttrTypes = map[string]attr.Type{
+	"index":                      types.Int64Type,
+	"availability_zone":          types.StringType,
+	"replica_availability_zones": types.ListType{ElemType: types.StringType},
+}
+
+var valkeyClusterNodeAttrTypes = map[string]attr.Type{
+	"address":           types.StringType,
+	"port":              types.Int64Type,
+	"role":              types.StringType,
+	"availability_zone": types.StringType,
+}
+
+var valkeyClusterPendingModificationsAttrTypes = map[string]attr.Type{
+	"node_instance_type":   types.StringType,
+	"engine_version":       types.StringType,
+	"parameter_group_name": types.StringType,
+}
+
+var valkeyClusterShardAttrTypes = map[string]attr.Type{
+	"index": types.Int64Type,
+	"nodes": types.ListType{ElemType: types.ObjectType{AttrTypes: valkeyClusterNodeAttrTypes}},
+}
+
 // ValkeyClusterResourceModel describes the resource data model.
 type ValkeyClusterResourceModel struct {
-	Id                  types.String          `tfsdk:"id"`
-	ClusterName         types.String          `tfsdk:"cluster_name"`
-	NodeInstanceType    types.String          `tfsdk:"node_instance_type"`
-	ShardCount          types.Int64           `tfsdk:"shard_count"`
-	ReplicationFactor   types.Int64           `tfsdk:"replication_factor"`
-	EnforceShardMultiAz types.Bool            `tfsdk:"enforce_shard_multi_az"`
-	ShardPlacements     []ShardPlacementModel `tfsdk:"shard_placements"`
-	Timeouts            timeouts.Value        `tfsdk:"timeouts"`
+	Id                       types.String          `tfsdk:"id"`
+	ClusterName              types.String          `tfsdk:"cluster_name"`
+	NodeInstanceType         types.String          `tfsdk:"node_instance_type"`
+	ShardCount               types.Int64           `tfsdk:"shard_count"`
+	ReplicationFactor        types.Int64           `tfsdk:"replication_factor"`
+	EnforceShardMultiAz      types.Bool            `tfsdk:"enforce_shard_multi_az"`
+	ShardPlacements          []ShardPlacementModel `tfsdk:"shard_placements"`
+	AvailabilityZones        types.List            `tfsdk:"availability_zones"`
+	EffectiveShardPlacements ShardPlacementsValue  `tfsdk:"effective_shard_placements"`
+	PollInterval             types.String          `tfsdk:"poll_interval"`
+	WaitForReady             types.Bool            `tfsdk:"wait_for_ready"`
+	IgnoreAutoscaledSize     types.Bool            `tfsdk:"ignore_autoscaled_size"`
+	InitialShardCount        types.Int64           `tfsdk:"initial_shard_count"`
+	InitialReplicationFactor types.Int64           `tfsdk:"initial_replication_factor"`
+	ForceDestroy             types.Bool            `tfsdk:"force_destroy"`
+	AllowCapacityReduction   types.Bool            `tfsdk:"allow_capacity_reduction"`
+	CreationRetryAttempts    types.Int64           `tfsdk:"creation_retry_attempts"`
+	RetainOnFailure          types.Bool            `tfsdk:"retain_on_failure"`
+	SnapshotName             types.String          `tfsdk:"snapshot_name"`
+	SnapshotBeforeUpdate     types.Bool            `tfsdk:"snapshot_before_update"`
+	EngineVersion            types.String          `tfsdk:"engine_version"`
+	ParameterGroupName       types.String          `tfsdk:"parameter_group_name"`
+	MaintenanceWindow        types.String          `tfsdk:"maintenance_window"`
+	ApplyImmediately         types.Bool            `tfsdk:"apply_immediately"`
+	PendingModifications     types.Object          `tfsdk:"pending_modifications"`
+	AuthMode                 types.String          `tfsdk:"auth_mode"`
+	TransitEncryption        types.Bool            `tfsdk:"transit_encryption"`
+	Tags                     types.Map             `tfsdk:"tags"`
+	TagsAll                  types.Map             `tfsdk:"tags_all"`
+	Status                   types.String          `tfsdk:"status"`
+	Health                   types.String          `tfsdk:"health"`
+	ConfigurationEndpoint    types.String          `tfsdk:"configuration_endpoint"`
+	Port                     types.Int64           `tfsdk:"port"`
+	TlsRequired              types.Bool            `tfsdk:"tls_required"`
+	Shards                   types.List            `tfsdk:"shards"`
+	CreatedAt                types.String          `tfsdk:"created_at"`
+	Errors                   types.List            `tfsdk:"errors"`
+	Timeouts                 timeouts.Value        `tfsdk:"timeouts"`
 }
 
 func (r *ValkeyClusterResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -64,7 +142,7 @@ func (r *ValkeyClusterResource) Metadata(ctx context.Context, req resource.Metad
 
 func (r *ValkeyClusterResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "A Valkey Cluster.",
+		MarkdownDescription: fmt.Sprintf("A Valkey Cluster. Updates that reduce the estimated capacity of the cluster below the operations per second allowed by the throttling limits of the object stores that use it fail, unless `allow_capacity_reduction` is set. The capacity is estimated at %d operations per second per vCPU of every node, primaries and replicas alike.", valkeyOpsPerVcpu),
 
 		Attributes: map[string]schema.Attribute{
 			// The testing framework requires an id attribute to be present in every data source and resource
@@ -76,29 +154,31 @@ func (r *ValkeyClusterResource) Schema(ctx context.Context, req resource.SchemaR
 				},
 			},
 			"cluster_name": schema.StringAttribute{
-				MarkdownDescription: "Name of the Valkey Cluster.",
+				MarkdownDescription: "Name of the Valkey Cluster. Changing the name destroys and recreates the cluster. To replace a cluster used by an object store without downtime, set `create_before_destroy` on the cluster and change its name, so that the new cluster is created and the `momento_object_store` is repointed to it before the old cluster is destroyed.",
 				Required:            true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"node_instance_type": schema.StringAttribute{
-				MarkdownDescription: "The instance type for nodes in the Valkey Cluster. Please refer to https://docs.aws.amazon.com/AmazonElastiCache/latest/dg/CacheNodes.SupportedTypes.html for supported instance types.",
+				MarkdownDescription: "The instance type for nodes in the Valkey Cluster. Please refer to https://docs.aws.amazon.com/AmazonElastiCache/latest/dg/CacheNodes.SupportedTypes.html for supported instance types. The type is checked during plan against the node types listed by the `momento_valkey_node_types` data source.",
 				Required:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
 			"shard_count": schema.Int64Attribute{
-				MarkdownDescription: "The number of shards.",
-				Required:            true,
+				MarkdownDescription: "The number of shards. Required, unless `ignore_autoscaled_size` is set, in which case it must not be configured and holds the current number of shards.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
 			"replication_factor": schema.Int64Attribute{
-				MarkdownDescription: "The number of replicas per shard.",
-				Required:            true,
+				MarkdownDescription: "The number of replicas per shard. Must be at least 1 when `enforce_shard_multi_az` is true. Required, unless `ignore_autoscaled_size` is set, in which case it must not be configured and holds the current number of replicas per shard.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
 				},
@@ -111,8 +191,9 @@ func (r *ValkeyClusterResource) Schema(ctx context.Context, req resource.SchemaR
 				},
 			},
 			"shard_placements": schema.ListNestedAttribute{
-				MarkdownDescription: "Optional explicit placement configuration for shards. If not specified, placements are determined automatically.",
+				MarkdownDescription: "Optional explicit placement configuration for shards. If not specified, placements are determined automatically. Placements are matched by `index`, so their order and the order of the replica availability zones do not matter. Changing the placements without changing `shard_count` or `replication_factor`, or changing the primary availability zone of an existing shard, destroys and recreates the cluster. Adding or removing `shard_placements` only starts or stops managing the placements, and does not change the cluster.",
 				Optional:            true,
+				CustomType:          NewShardPlacementsType(),
 				PlanModifiers: []planmodifier.List{
 					listplanmodifier.UseStateForUnknown(),
 				},
@@ -134,6 +215,240 @@ func (r *ValkeyClusterResource) Schema(ctx context.Context, req resource.SchemaR
 					},
 				},
 			},
+			"availability_zones": schema.ListAttribute{
+				MarkdownDescription: "Availability zones to spread the cluster across. Conflicts with `shard_placements`. The provider generates balanced placements from them: primaries are spread round-robin and replicas are spread across the zones, never in their primary's zone when `enforce_shard_multi_az` is true. Existing shards keep their placements, so changing the zones only affects new shards and replicas. When `shard_count` is decreased the shards with the highest indexes are removed.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"effective_shard_placements": schema.ListNestedAttribute{
+				MarkdownDescription: "The shard placements of the Valkey Cluster, whether configured with `shard_placements`, generated from `availability_zones` or chosen by Momento.",
+				Computed:            true,
+				CustomType:          NewShardPlacementsType(),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"index": schema.Int64Attribute{
+							MarkdownDescription: "The 0-based index of the shard.",
+							Computed:            true,
+						},
+						"availability_zone": schema.StringAttribute{
+							MarkdownDescription: "The availability zone for the primary node.",
+							Computed:            true,
+						},
+						"replica_availability_zones": schema.ListAttribute{
+							ElementType:         types.StringType,
+							MarkdownDescription: "The availability zones for replica nodes.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"poll_interval": schema.StringAttribute{
+				MarkdownDescription: "How long to wait between checks of the cluster status while it is created, updated or deleted, as a duration such as `15s`. The interval backs off while the status does not change, up to 2 minutes or the configured interval if longer. Defaults to `30s`.",
+				Optional:            true,
+			},
+			"wait_for_ready": schema.BoolAttribute{
+				MarkdownDescription: "Whether creation waits for the cluster to become active. When false, creation returns as soon as the cluster has been requested and its connection details are populated by a later refresh; use `momento_valkey_cluster_waiter` to wait for the cluster where it is needed. Updates always wait, since each update step requires an active cluster. Defaults to true.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"ignore_autoscaled_size": schema.BoolAttribute{
+				MarkdownDescription: "Whether the size of the cluster is left to a `momento_valkey_cluster_autoscaling` policy. The cluster is created with `initial_shard_count` and `initial_replication_factor`, `shard_count` and `replication_factor` must not be configured, and they hold the size chosen by the autoscaler so that plans do not revert it. Conflicts with `shard_placements`, whose shards would not match the autoscaled size. Defaults to false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"initial_shard_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of shards the cluster is created with when `ignore_autoscaled_size` is set, in which case it is required. Changing it after creation has no effect.",
+				Optional:            true,
+			},
+			"initial_replication_factor": schema.Int64Attribute{
+				MarkdownDescription: "The number of replicas per shard the cluster is created with when `ignore_autoscaled_size` is set, in which case it is required. Must be at least 1 when `enforce_shard_multi_az` is true. Changing it after creation has no effect.",
+				Optional:            true,
+			},
+			"force_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Whether destroying the cluster first deletes the object stores that use it. When false, destroying a cluster that object stores still use fails with their names, so that they are not left without a cache. The setting must be applied before the cluster is destroyed to take effect. Defaults to false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"allow_capacity_reduction": schema.BoolAttribute{
+				MarkdownDescription: "Whether an update is still made, with a warning, when it reduces the estimated capacity of the cluster below the operations per second allowed by the throttling limits of the object stores that use it. Set it when the estimate does not fit the workload, or when those limits are lowered in the same apply. Defaults to false.",
+				Optional:            true,
+			},
+			"creation_retry_attempts": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("How many times a cluster whose creation fails is deleted and created again, from 0 to %d. The errors reported for each failed attempt are shown as warnings. All attempts must complete within the create timeout. Only applies when `wait_for_ready` is true. Defaults to 0.", valkeyMaxCreationRetryAttempts),
+				Optional:            true,
+			},
+			"retain_on_failure": schema.BoolAttribute{
+				MarkdownDescription: "Whether a cluster whose creation fails, after any `creation_retry_attempts`, is kept instead of deleted. The failed cluster is saved in state with its `errors`, and marked as tainted so that the next apply replaces it. Only applies when `wait_for_ready` is true. Defaults to false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"snapshot_name": schema.StringAttribute{
+				MarkdownDescription: "Name of a snapshot to seed the Valkey Cluster with when it is created. Changing the snapshot destroys and recreates the cluster.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"engine_version": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The Valkey engine version, one of %s. Defaults to the latest version chosen by Momento when the cluster is created. Increasing the version upgrades the cluster in place, one version at a time. Decreasing the version destroys and recreates the cluster.", "`"+strings.Join(valkeyEngineVersions, "`, `")+"`"),
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"parameter_group_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the `momento_valkey_parameter_group` with the server parameters of the Valkey Cluster. The parameter group must be for the cluster's `engine_version`; when the version is upgraded, the parameter group is changed with the final upgrade. Defaults to the default parameter group of the engine version.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"maintenance_window": schema.StringAttribute{
+				MarkdownDescription: "The weekly time range in UTC during which changes that are not applied immediately are made, in the format `ddd:hh:mm-ddd:hh:mm` such as `sun:05:00-sun:06:00`. The window must be at least 60 minutes long. Defaults to a window chosen by Momento.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"apply_immediately": schema.BoolAttribute{
+				MarkdownDescription: "Whether changes to `node_instance_type`, `engine_version` and `parameter_group_name` are applied immediately. When false, the changes are queued until the next `maintenance_window` and are listed in `pending_modifications` until then, and an engine upgrade must be to the next engine version. Other changes are always applied immediately. Defaults to true.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"pending_modifications": schema.SingleNestedAttribute{
+				MarkdownDescription: "The changes queued for the next maintenance window, or null when there are none. `node_instance_type`, `engine_version` and `parameter_group_name` show the queued values, so they differ from the values the cluster is running until the window.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"node_instance_type": schema.StringAttribute{
+						MarkdownDescription: "The queued node instance type.",
+						Computed:            true,
+					},
+					"engine_version": schema.StringAttribute{
+						MarkdownDescription: "The queued engine version.",
+						Computed:            true,
+					},
+					"parameter_group_name": schema.StringAttribute{
+						MarkdownDescription: "The queued parameter group.",
+						Computed:            true,
+					},
+				},
+			},
+			"auth_mode": schema.StringAttribute{
+				MarkdownDescription: "How clients authenticate, `none` or `acl`. With `acl`, clients authenticate as a `momento_valkey_user` and are limited to its access string, which requires `transit_encryption`. Changing the mode updates the cluster in place. Defaults to the mode chosen by Momento when the cluster is created.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"transit_encryption": schema.BoolAttribute{
+				MarkdownDescription: "Whether connections to the Valkey Cluster are encrypted with TLS. Changing it destroys and recreates the cluster. Defaults to the setting chosen by Momento when the cluster is created.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplaceIfConfigured(),
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"snapshot_before_update": schema.BoolAttribute{
+				MarkdownDescription: "Whether to snapshot the Valkey Cluster before an update removes shards or replicas or changes `node_instance_type`. The snapshot is named `<cluster_name>-pre-update-<UTC timestamp>` and is not managed by Terraform, so it is kept until it is deleted separately. Defaults to false.",
+				Optional:            true,
+			},
+			"tags": schema.MapAttribute{
+				MarkdownDescription: "Tags to assign to the Valkey Cluster. Tags override provider `default_tags` with the same key. Changing the tags updates the cluster in place without changing its nodes.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"tags_all": schema.MapAttribute{
+				MarkdownDescription: "All tags of the Valkey Cluster, including those inherited from the provider `default_tags`.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the Valkey Cluster, e.g. `Active`.",
+				Computed:            true,
+			},
+			"health": schema.StringAttribute{
+				MarkdownDescription: "The health of the Valkey Cluster: `failed` when its creation failed, `degraded` when it reports `errors`, and `healthy` otherwise.",
+				Computed:            true,
+			},
+			"configuration_endpoint": schema.StringAttribute{
+				MarkdownDescription: "The cluster configuration (discovery) endpoint hostname that cluster-mode clients should connect to.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "The port of the configuration endpoint.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"tls_required": schema.BoolAttribute{
+				MarkdownDescription: "Whether clients must connect to the Valkey Cluster using TLS.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"shards": schema.ListNestedAttribute{
+				MarkdownDescription: "The node endpoints of each shard.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"index": schema.Int64Attribute{
+							MarkdownDescription: "The 0-based index of the shard.",
+							Computed:            true,
+						},
+						"nodes": schema.ListNestedAttribute{
+							MarkdownDescription: "The nodes of the shard.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"address": schema.StringAttribute{
+										MarkdownDescription: "The hostname of the node.",
+										Computed:            true,
+									},
+									"port": schema.Int64Attribute{
+										MarkdownDescription: "The port of the node.",
+										Computed:            true,
+									},
+									"role": schema.StringAttribute{
+										MarkdownDescription: "The role of the node, `primary` or `replica`.",
+										Computed:            true,
+									},
+									"availability_zone": schema.StringAttribute{
+										MarkdownDescription: "The availability zone of the node.",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The time the Valkey Cluster was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"errors": schema.ListAttribute{
+				MarkdownDescription: "The errors last reported for the Valkey Cluster.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -181,6 +496,9 @@ func validateCreateValkeyClusterTerraformPlan(plan *ValkeyClusterResourceModel)
 			Detail:        "The enforce_shard_multi_az boolean value is required.",
 		}
 	}
+	if attrErr := validateValkeyClusterMultiAz(plan.ReplicationFactor, plan.EnforceShardMultiAz); attrErr != nil {
+		return attrErr
+	}
 	// Validate length of shard_placements matches shard_count, number of replica_availability_zones in each shard placement
 	// matches replication_factor, and that shard indexes are non-negative. Return any validation errors in the response diagnostics.
 	if plan.ShardPlacements != nil {
@@ -212,115 +530,618 @@ func validateCreateValkeyClusterTerraformPlan(plan *ValkeyClusterResourceModel)
 	return nil
 }
 
-func (r *ValkeyClusterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	// Prevent panic if the provider has not been configured.
-	if req.ProviderData == nil {
-		return
+// validateValkeyClusterMultiAz checks that multi-AZ placement is not enforced without replicas, which the API rejects
+// with "Must have at least 1 replica for Multi-AZ enabled Replication Group". Unknown values are not checked.
+func validateValkeyClusterMultiAz(replicationFactor types.Int64, enforceShardMultiAz types.Bool) *AttributeError {
+	if replicationFactor.IsNull() || replicationFactor.IsUnknown() || enforceShardMultiAz.IsNull() || enforceShardMultiAz.IsUnknown() {
+		return nil
+	}
+	if replicationFactor.ValueInt64() == 0 && enforceShardMultiAz.ValueBool() {
+		return &AttributeError{
+			AttributePath: path.Root("enforce_shard_multi_az"),
+			Summary:       "Invalid value",
+			Detail:        "enforce_shard_multi_az must be false when replication_factor is 0, since multi-AZ placement requires at least one replica per shard.",
+		}
 	}
+	return nil
+}
 
-	clients, ok := req.ProviderData.(MomentoClients)
+var maintenanceWindowRegex = regexp.MustCompile(`^(mon|tue|wed|thu|fri|sat|sun):([01]\d|2[0-3]):([0-5]\d)-(mon|tue|wed|thu|fri|sat|sun):([01]\d|2[0-3]):([0-5]\d)$`)
 
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected MomentoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
+var maintenanceWindowDays = []string{"mon", "tue", "wed", "thu", "fri", "sat", "sun"}
 
-		return
+// validateMaintenanceWindow checks that a maintenance window is a weekly time range of at least 60 minutes. Unknown
+// values are not checked.
+func validateMaintenanceWindow(maintenanceWindow types.String) *AttributeError {
+	if maintenanceWindow.IsNull() || maintenanceWindow.IsUnknown() {
+		return nil
 	}
-
-	r.httpClient = clients.httpClient
-	r.httpEndpoint = clients.httpEndpoint
-	r.httpAuthToken = clients.httpAuthToken
+	matches := maintenanceWindowRegex.FindStringSubmatch(maintenanceWindow.ValueString())
+	if matches == nil {
+		return &AttributeError{
+			AttributePath: path.Root("maintenance_window"),
+			Summary:       "Invalid value",
+			Detail:        fmt.Sprintf("maintenance_window must be in the format ddd:hh:mm-ddd:hh:mm, such as \"sun:05:00-sun:06:00\", got %q.", maintenanceWindow.ValueString()),
+		}
+	}
+	minuteOfWeek := func(day string, hour string, minute string) int {
+		h, _ := strconv.Atoi(hour)
+		m, _ := strconv.Atoi(minute)
+		return slices.Index(maintenanceWindowDays, day)*24*60 + h*60 + m
+	}
+	const minutesPerWeek = 7 * 24 * 60
+	length := (minuteOfWeek(matches[4], matches[5], matches[6]) - minuteOfWeek(matches[1], matches[2], matches[3]) + minutesPerWeek) % minutesPerWeek
+	if length < 60 {
+		return &AttributeError{
+			AttributePath: path.Root("maintenance_window"),
+			Summary:       "Invalid value",
+			Detail:        fmt.Sprintf("maintenance_window must be at least 60 minutes long, got %q.", maintenanceWindow.ValueString()),
+		}
+	}
+	return nil
 }
 
-func (r *ValkeyClusterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan ValkeyClusterResourceModel
+// validateValkeyClusterUpdate checks a planned change against the current state of the cluster. When the change
+// cannot be made in place, requiresReplace reports whether destroying and recreating the cluster would apply it.
+func validateValkeyClusterUpdate(currentState *ValkeyClusterResourceModel, plan *ValkeyClusterResourceModel) (attrErr *AttributeError, requiresReplace bool) {
+	diff := determineDiff(*currentState, *plan)
 
-	// Retrieve values from the plan
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	// Engine versions can only be upgraded in place
+	if diff["engine_version"] && isValkeyEngineDowngrade(currentState.EngineVersion.ValueString(), plan.EngineVersion.ValueString()) {
+		return &AttributeError{
+			AttributePath: path.Root("engine_version"),
+			Summary:       "Invalid Update",
+			Detail:        fmt.Sprintf("Downgrading the engine version from %s to %s cannot be made in place, the cluster must be recreated.", currentState.EngineVersion.ValueString(), plan.EngineVersion.ValueString()),
+		}, true
+	}
 
-	if resp.Diagnostics.HasError() {
-		return
+	// Only one engine upgrade can be queued for the maintenance window
+	if diff["engine_version"] && !plan.ApplyImmediately.ValueBool() && len(valkeyEngineUpgradePath(currentState.EngineVersion.ValueString(), plan.EngineVersion.ValueString())) > 1 {
+		return &AttributeError{
+			AttributePath: path.Root("engine_version"),
+			Summary:       "Invalid Update",
+			Detail:        fmt.Sprintf("Upgrading the engine version from %s to %s goes through more than one version, which requires apply_immediately to be true. Upgrade to the next version first.", currentState.EngineVersion.ValueString(), plan.EngineVersion.ValueString()),
+		}, false
 	}
 
-	createTimeout, diags := plan.Timeouts.Create(ctx, 120*time.Minute)
-	resp.Diagnostics.Append(diags...)
+	// Updates to shard_placements without accompanying change to shard_count or replication_factor are not supported by the API
+	if diff["shard_placements"] && !diff["shard_count"] && !diff["replication_factor"] {
+		return &AttributeError{
+			AttributePath: path.Root("shard_placements"),
+			Summary:       "Invalid Update",
+			Detail:        "Updates to shard_placements without accompanying change to shard_count or replication_factor cannot be made in place, the cluster must be recreated.",
+		}, true
+	}
+
+	// The replica/shard updates may accept shard_placements updates, but not a change in primary AZ for each shard
+	currentAZs := make(map[int64]string, len(currentState.ShardPlacements))
+	for _, sp := range currentState.ShardPlacements {
+		currentAZs[sp.Index.ValueInt64()] = sp.AvailabilityZone.ValueString()
+	}
+	for i, sp := range plan.ShardPlacements {
+		if currentAZ, ok := currentAZs[sp.Index.ValueInt64()]; ok && currentAZ != sp.AvailabilityZone.ValueString() {
+			return &AttributeError{
+				AttributePath: path.Root("shard_placements").AtListIndex(i).AtName("availability_zone"),
+				Summary:       "Invalid Update",
+				Detail:        fmt.Sprintf("Changing the primary availability zone of shard %d cannot be made in place, the cluster must be recreated.", sp.Index.ValueInt64()),
+			}, true
+		}
+	}
+	return nil, false
+}
+
+func (r *ValkeyClusterResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// Values may be unknown during validation, so read only the attributes that are checked.
+	var shardCount, replicationFactor, initialShardCount, initialReplicationFactor, creationRetryAttempts types.Int64
+	var enforceShardMultiAz, transitEncryption, ignoreAutoscaledSize types.Bool
+	var shardPlacements ShardPlacementsValue
+	var availabilityZones types.List
+	var nodeInstanceType, pollInterval, engineVersion, maintenanceWindow, authMode types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("node_instance_type"), &nodeInstanceType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("shard_count"), &shardCount)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("replication_factor"), &replicationFactor)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("enforce_shard_multi_az"), &enforceShardMultiAz)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("shard_placements"), &shardPlacements)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("availability_zones"), &availabilityZones)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("poll_interval"), &pollInterval)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("engine_version"), &engineVersion)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("maintenance_window"), &maintenanceWindow)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("auth_mode"), &authMode)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("transit_encryption"), &transitEncryption)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("ignore_autoscaled_size"), &ignoreAutoscaledSize)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("initial_shard_count"), &initialShardCount)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("initial_replication_factor"), &initialReplicationFactor)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("creation_retry_attempts"), &creationRetryAttempts)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	ctx, cancel := context.WithTimeout(ctx, createTimeout)
-	defer cancel()
 
-	if validationErr := validateCreateValkeyClusterTerraformPlan(&plan); validationErr != nil {
+	// The sizes are computed so that they can follow the autoscaler, in which case the initial sizes are configured
+	// instead. The configured sizes are validated below.
+	if !ignoreAutoscaledSize.IsUnknown() {
+		for _, attrErr := range validateValkeyClusterSizeArguments(ignoreAutoscaledSize.ValueBool(), shardCount, replicationFactor, initialShardCount, initialReplicationFactor) {
+			resp.Diagnostics.AddAttributeError(attrErr.AttributePath, attrErr.Summary, attrErr.Detail)
+		}
+		if ignoreAutoscaledSize.ValueBool() {
+			replicationFactor = initialReplicationFactor
+		}
+	}
+	if ignoreAutoscaledSize.ValueBool() && !shardPlacements.IsNull() {
 		resp.Diagnostics.AddAttributeError(
-			validationErr.AttributePath,
-			validationErr.Summary,
-			validationErr.Detail,
+			path.Root("ignore_autoscaled_size"),
+			"Conflicting configuration",
+			"ignore_autoscaled_size cannot be used with shard_placements, since the autoscaler changes the number of shards and replicas. Use availability_zones instead.",
 		)
-		return
 	}
 
-	client := *r.httpClient
-	postUrl := fmt.Sprintf("%s/ec-cluster", r.httpEndpoint)
+	if attrErr := validatePollInterval(pollInterval); attrErr != nil {
+		resp.Diagnostics.AddAttributeError(attrErr.AttributePath, attrErr.Summary, attrErr.Detail)
+	}
 
-	// Create map of request body to marshal into JSON
-	requestMap := map[string]interface{}{
-		"name":                   plan.ClusterName.ValueString(),
-		"node_instance_type":     plan.NodeInstanceType.ValueString(),
-		"shard_count":            plan.ShardCount.ValueInt64(),
-		"replication_factor":     plan.ReplicationFactor.ValueInt64(),
-		"enforce_shard_multi_az": plan.EnforceShardMultiAz.ValueBool(),
+	if !creationRetryAttempts.IsNull() && !creationRetryAttempts.IsUnknown() && (creationRetryAttempts.ValueInt64() < 0 || creationRetryAttempts.ValueInt64() > valkeyMaxCreationRetryAttempts) {
+		resp.Diagnostics.AddAttributeError(path.Root("creation_retry_attempts"), "Invalid value", fmt.Sprintf("creation_retry_attempts must be between 0 and %d.", valkeyMaxCreationRetryAttempts))
 	}
-	if len(plan.ShardPlacements) > 0 {
-		placements := make([]map[string]interface{}, len(plan.ShardPlacements))
-		for i, sp := range plan.ShardPlacements {
-			replicaAZs := make([]string, len(sp.ReplicaAvailabilityZones))
-			for j, az := range sp.ReplicaAvailabilityZones {
-				replicaAZs[j] = az.ValueString()
-			}
-			placements[i] = map[string]interface{}{
-				"shard_index":                sp.Index.ValueInt64(),
-				"availability_zone":          sp.AvailabilityZone.ValueString(),
-				"replica_availability_zones": replicaAZs,
-			}
+
+	if attrErr := validateValkeyNodeInstanceType(path.Root("node_instance_type"), nodeInstanceType); attrErr != nil {
+		resp.Diagnostics.AddAttributeError(attrErr.AttributePath, attrErr.Summary, attrErr.Detail)
+	}
+
+	if attrErr := validateValkeyEngineVersion(path.Root("engine_version"), engineVersion); attrErr != nil {
+		resp.Diagnostics.AddAttributeError(attrErr.AttributePath, attrErr.Summary, attrErr.Detail)
+	}
+
+	if attrErr := validateMaintenanceWindow(maintenanceWindow); attrErr != nil {
+		resp.Diagnostics.AddAttributeError(attrErr.AttributePath, attrErr.Summary, attrErr.Detail)
+	}
+
+	if !authMode.IsNull() && !authMode.IsUnknown() {
+		if authMode.ValueString() != "none" && authMode.ValueString() != "acl" {
+			resp.Diagnostics.AddAttributeError(path.Root("auth_mode"), "Invalid value", fmt.Sprintf("auth_mode must be one of none, acl, got %q.", authMode.ValueString()))
+		} else if authMode.ValueString() == "acl" && !transitEncryption.IsNull() && !transitEncryption.IsUnknown() && !transitEncryption.ValueBool() {
+			resp.Diagnostics.AddAttributeError(path.Root("auth_mode"), "Invalid value", "auth_mode acl requires transit_encryption, since passwords must not be sent unencrypted.")
 		}
-		requestMap["shard_placements"] = placements
 	}
 
-	requestJson, err := json.Marshal(requestMap)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to marshal request body, got error: %s", err))
-		return
+	if attrErr := validateValkeyClusterMultiAz(replicationFactor, enforceShardMultiAz); attrErr != nil {
+		resp.Diagnostics.AddAttributeError(attrErr.AttributePath, attrErr.Summary, attrErr.Detail)
 	}
-	requestBody := bytes.NewBuffer(requestJson)
-	postRequest, err := http.NewRequest("POST", postUrl, requestBody)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create HTTP request to create valkey cluster, got error: %s", err))
+
+	if availabilityZones.IsNull() || availabilityZones.IsUnknown() {
 		return
 	}
-	postRequest.Header.Set("Content-Type", "application/json")
-	postRequest.Header.Set("Authorization", r.httpAuthToken)
-	httpResp, err := client.Do(postRequest)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create valkey cluster, got error: %s", err))
+	if !shardPlacements.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("availability_zones"),
+			"Conflicting configuration",
+			"Only one of availability_zones and shard_placements may be specified.",
+		)
 		return
 	}
-	defer func() { _ = httpResp.Body.Close() }()
-	if httpResp.StatusCode >= 300 {
-		body, _ := io.ReadAll(httpResp.Body)
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create valkey cluster, got non-200 response: %s %s", httpResp.Status, string(body)))
+	seen := make(map[string]bool, len(availabilityZones.Elements()))
+	for i, element := range availabilityZones.Elements() {
+		az, ok := element.(types.String)
+		if !ok || az.IsUnknown() {
+			return
+		}
+		if az.IsNull() || az.ValueString() == "" || seen[az.ValueString()] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("availability_zones").AtListIndex(i),
+				"Invalid value",
+				"Availability zones must be non-empty and unique.",
+			)
+			return
+		}
+		seen[az.ValueString()] = true
+	}
+	if len(seen) == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("availability_zones"), "Invalid value", "At least one availability zone is required.")
+		return
+	}
+	if !enforceShardMultiAz.IsUnknown() && enforceShardMultiAz.ValueBool() && len(seen) < 2 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("availability_zones"),
+			"Invalid value",
+			"At least two availability zones are required when enforce_shard_multi_az is true.",
+		)
+	}
+}
+
+// validateValkeyClusterSizeArguments checks that the sizes are configured when the cluster manages its size, and that
+// only the initial sizes are configured when the size is left to the autoscaler.
+func validateValkeyClusterSizeArguments(ignoreAutoscaledSize bool, shardCount types.Int64, replicationFactor types.Int64, initialShardCount types.Int64, initialReplicationFactor types.Int64) []*AttributeError {
+	var attrErrs []*AttributeError
+	sizes := map[string]types.Int64{"shard_count": shardCount, "replication_factor": replicationFactor}
+	initialSizes := map[string]types.Int64{"initial_shard_count": initialShardCount, "initial_replication_factor": initialReplicationFactor}
+	required, conflicting := sizes, initialSizes
+	reason := "is only used when ignore_autoscaled_size is true"
+	if ignoreAutoscaledSize {
+		required, conflicting = initialSizes, sizes
+		reason = "cannot be configured when ignore_autoscaled_size is true, since the autoscaler sets the size of the cluster. Configure the initial size with initial_shard_count and initial_replication_factor"
+	}
+	for _, name := range []string{"shard_count", "replication_factor", "initial_shard_count", "initial_replication_factor"} {
+		if value, ok := required[name]; ok && value.IsNull() {
+			attrErrs = append(attrErrs, &AttributeError{
+				AttributePath: path.Root(name),
+				Summary:       "Missing required argument",
+				Detail:        fmt.Sprintf("The argument %q is required.", name),
+			})
+		}
+		if value, ok := conflicting[name]; ok && !value.IsNull() {
+			attrErrs = append(attrErrs, &AttributeError{
+				AttributePath: path.Root(name),
+				Summary:       "Conflicting configuration",
+				Detail:        fmt.Sprintf("%s %s.", name, reason),
+			})
+		}
+	}
+	return attrErrs
+}
+
+// planValkeyClusterInitialSize plans the size of a new cluster from the initial sizes when the sizes are not
+// configured.
+func planValkeyClusterInitialSize(ctx context.Context, config tfsdk.Config, plan *tfsdk.Plan) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for attribute, initialAttribute := range map[string]string{"shard_count": "initial_shard_count", "replication_factor": "initial_replication_factor"} {
+		var configured, initial types.Int64
+		diags.Append(config.GetAttribute(ctx, path.Root(attribute), &configured)...)
+		diags.Append(config.GetAttribute(ctx, path.Root(initialAttribute), &initial)...)
+		if diags.HasError() {
+			return diags
+		}
+		if configured.IsNull() && !initial.IsNull() {
+			diags.Append(plan.SetAttribute(ctx, path.Root(attribute), initial)...)
+		}
+	}
+	return diags
+}
+
+// Plans the effective shard placements, and rejects updates the cluster cannot make in place during plan or plans
+// a replacement where recreating the cluster would apply them.
+func (r *ValkeyClusterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Destroying a cluster that object stores use fails unless force_destroy is set. The object stores may be destroyed
+	// or repointed earlier in the same apply, so they are only reported.
+	if req.Plan.Raw.IsNull() {
+		var clusterName types.String
+		var forceDestroy types.Bool
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("cluster_name"), &clusterName)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("force_destroy"), &forceDestroy)...)
+		if resp.Diagnostics.HasError() || r.httpClient == nil {
+			return
+		}
+		dependents, err := findValkeyClusterDependents(*r.httpClient, clusterName.ValueString(), r.httpEndpoint, r.httpAuthToken)
+		if err != nil {
+			resp.Diagnostics.AddWarning("Unable to list object stores", fmt.Sprintf("Unable to check whether object stores use cluster %q: %s.", clusterName.ValueString(), err))
+			return
+		}
+		if len(dependents) == 0 {
+			return
+		}
+		if forceDestroy.ValueBool() {
+			resp.Diagnostics.AddWarning("Object Stores Will Be Deleted", fmt.Sprintf("Cluster %q is used by object stores %s, which are deleted along with it since force_destroy is set.", clusterName.ValueString(), valkeyClusterDependentNames(dependents)))
+		} else {
+			resp.Diagnostics.AddWarning("Cluster In Use", fmt.Sprintf("Cluster %q is used by object stores %s. Destroying it fails unless they are destroyed or repointed to another cluster first, or force_destroy is set.", clusterName.ValueString(), valkeyClusterDependentNames(dependents)))
+		}
+		return
+	}
+
+	resp.Diagnostics.Append(planTagsAll(ctx, r.defaultTags, &resp.Plan)...)
+
+	// A cluster whose size is left to the autoscaler is created with the initial sizes. Afterwards the sizes are not
+	// configured, so they are kept from state, which holds the size chosen by the autoscaler.
+	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planValkeyClusterInitialSize(ctx, req.Config, &resp.Plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// The checks compare shard placements, which are only known once the values they reference are.
+	var plannedPlacements ShardPlacementsValue
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("shard_placements"), &plannedPlacements)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if placementsValue, err := plannedPlacements.ToTerraformValue(ctx); err != nil || !placementsValue.IsFullyKnown() {
+		return
+	}
+
+	var plan ValkeyClusterResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.ShardCount.IsUnknown() || plan.ReplicationFactor.IsUnknown() || plan.EnforceShardMultiAz.IsUnknown() {
+		return
+	}
+
+	var state *ValkeyClusterResourceModel
+	if !req.State.Raw.IsNull() {
+		state = &ValkeyClusterResourceModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Placements are known up front when they are configured or generated from availability_zones
+	var effective []ShardPlacementModel
+	if plan.ShardPlacements != nil {
+		effective = plan.ShardPlacements
+	} else if !plan.AvailabilityZones.IsNull() && !plan.AvailabilityZones.IsUnknown() {
+		var availabilityZones []string
+		resp.Diagnostics.Append(plan.AvailabilityZones.ElementsAs(ctx, &availabilityZones, false)...)
+		var existing []ShardPlacementModel
+		if state != nil {
+			var diags diag.Diagnostics
+			existing, diags = shardPlacementsFromList(ctx, state.EffectiveShardPlacements)
+			resp.Diagnostics.Append(diags...)
+		}
+		effective = balanceShardPlacements(existing, availabilityZones, plan.ShardCount.ValueInt64(), plan.ReplicationFactor.ValueInt64(), plan.EnforceShardMultiAz.ValueBool())
+	}
+	if effective != nil {
+		effectiveList, diags := shardPlacementsToList(ctx, effective)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		plan.EffectiveShardPlacements = effectiveList
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+	}
+
+	// Only updates are checked
+	if state == nil {
+		return
+	}
+
+	// Capacity the object stores using the cluster rely on is not removed unless allow_capacity_reduction is set. The
+	// object stores are checked again during apply, so failing to list them only fails the apply.
+	if r.httpClient != nil && !plan.NodeInstanceType.IsUnknown() {
+		capacityErr, err := checkValkeyClusterCapacityForDependents(*r.httpClient, r.httpEndpoint, r.httpAuthToken, state, &plan)
+		if err != nil {
+			resp.Diagnostics.AddWarning("Unable to check object stores", fmt.Sprintf("Unable to check the capacity required by the object stores using cluster %q: %s.", state.ClusterName.ValueString(), err))
+		} else if capacityErr != nil {
+			appendValkeyClusterCapacityDiagnostic(&resp.Diagnostics, capacityErr, plan.AllowCapacityReduction.ValueBool())
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	attrErr, requiresReplace := validateValkeyClusterUpdate(state, &plan)
+	if attrErr == nil {
+		return
+	}
+	if requiresReplace {
+		resp.RequiresReplace = append(resp.RequiresReplace, attrErr.AttributePath)
+		return
+	}
+	resp.Diagnostics.AddAttributeError(attrErr.AttributePath, attrErr.Summary, attrErr.Detail)
+}
+
+func (r *ValkeyClusterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(MomentoClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected MomentoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.httpClient = clients.httpClient
+	r.httpEndpoint = clients.httpEndpoint
+	r.httpAuthToken = clients.httpAuthToken
+	r.defaultTags = clients.defaultTags
+}
+
+func (r *ValkeyClusterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ValkeyClusterResourceModel
+
+	// Retrieve values from the plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, 120*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Initial sizes that were unknown during plan are only known now
+	if plan.ShardCount.IsUnknown() && plan.IgnoreAutoscaledSize.ValueBool() {
+		plan.ShardCount = plan.InitialShardCount
+	}
+	if plan.ReplicationFactor.IsUnknown() && plan.IgnoreAutoscaledSize.ValueBool() {
+		plan.ReplicationFactor = plan.InitialReplicationFactor
+	}
+
+	if validationErr := validateCreateValkeyClusterTerraformPlan(&plan); validationErr != nil {
+		resp.Diagnostics.AddAttributeError(
+			validationErr.AttributePath,
+			validationErr.Summary,
+			validationErr.Detail,
+		)
+		return
+	}
+
+	// Create map of request body to marshal into JSON
+	requestMap := map[string]interface{}{
+		"name":                   plan.ClusterName.ValueString(),
+		"node_instance_type":     plan.NodeInstanceType.ValueString(),
+		"shard_count":            plan.ShardCount.ValueInt64(),
+		"replication_factor":     plan.ReplicationFactor.ValueInt64(),
+		"enforce_shard_multi_az": plan.EnforceShardMultiAz.ValueBool(),
+	}
+	// Placements generated from availability_zones during plan are sent as if they were configured
+	shardPlacements := plan.ShardPlacements
+	if shardPlacements == nil && !plan.AvailabilityZones.IsNull() {
+		generated, diags := shardPlacementsFromList(ctx, plan.EffectiveShardPlacements)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		shardPlacements = generated
+	}
+	if len(shardPlacements) > 0 {
+		requestMap["shard_placements"] = shardPlacementsToAPIFormat(shardPlacements)
+	}
+	if !plan.SnapshotName.IsNull() {
+		requestMap["snapshot_name"] = plan.SnapshotName.ValueString()
+	}
+	if !plan.EngineVersion.IsUnknown() && !plan.EngineVersion.IsNull() {
+		requestMap["engine_version"] = plan.EngineVersion.ValueString()
+	}
+	if !plan.ParameterGroupName.IsUnknown() && !plan.ParameterGroupName.IsNull() {
+		requestMap["parameter_group_name"] = plan.ParameterGroupName.ValueString()
+	}
+	if !plan.MaintenanceWindow.IsUnknown() && !plan.MaintenanceWindow.IsNull() {
+		requestMap["maintenance_window"] = plan.MaintenanceWindow.ValueString()
+	}
+	if !plan.AuthMode.IsUnknown() && !plan.AuthMode.IsNull() {
+		requestMap["auth_mode"] = plan.AuthMode.ValueString()
+	}
+	if !plan.TransitEncryption.IsUnknown() && !plan.TransitEncryption.IsNull() {
+		requestMap["transit_encryption"] = plan.TransitEncryption.ValueBool()
+	}
+	tagsAllValue, diags := tagsAll(ctx, r.defaultTags, plan.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.TagsAll = tagsAllValue
+	if len(plan.TagsAll.Elements()) > 0 {
+		requestMap["tags"] = tagsFromMap(plan.TagsAll)
+	}
+
+	requestJson, err := json.Marshal(requestMap)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to marshal request body, got error: %s", err))
 		return
 	}
 
 	// Map response body to schema and populate computed attribute values
 	plan.Id = types.StringValue(plan.ClusterName.ValueString())
+	planned := plan
+	clusterName := plan.ClusterName.ValueString()
+
+	// A cluster whose creation fails is deleted and requested again, up to creation_retry_attempts times
+	retryAttempts := int(plan.CreationRetryAttempts.ValueInt64())
+	for attempt := 0; ; attempt++ {
+		if err := r.requestCluster(requestJson); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create valkey cluster, got error: %s", err))
+			return
+		}
 
-	// Save data into Terraform state
+		// Save data into Terraform state. Computed attributes are unknown until the cluster is described.
+		plan = planned
+		resp.Diagnostics.Append(setValkeyClusterComputedAttributes(ctx, &plan, nil)...)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		plan = planned
+
+		// Record the status of the requested cluster without waiting for it. The cluster may not be described until it is
+		// registered, in which case the computed attributes are populated by the next refresh.
+		if !plan.WaitForReady.ValueBool() {
+			foundCluster, err := describeValkeyCluster(*r.httpClient, clusterName, r.httpEndpoint, r.httpAuthToken)
+			if err != nil {
+				tflog.Warn(ctx, "Unable to describe requested valkey cluster", map[string]interface{}{"cluster_name": clusterName, "error": err.Error()})
+			}
+			resp.Diagnostics.Append(setValkeyClusterComputedAttributes(ctx, &plan, foundCluster)...)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+			return
+		}
+
+		// Poll until cluster status is "Active" or "CreationFailed"
+		foundCluster, err := waitUntilValkeyClusterActive(ctx, *r.httpClient, clusterName, r.httpEndpoint, r.httpAuthToken, pollIntervalFromConfig(plan.PollInterval))
+		if err != nil {
+			resp.Diagnostics.AddError("Cluster Creation Failed", fmt.Sprintf("Cluster \"%s\" was created but did not become active: %s", clusterName, err))
+			return
+		}
+		if foundCluster.Status != "CreationFailed" {
+			break
+		}
+		failure := fmt.Sprintf("Cluster \"%s\" failed to create%s", clusterName, formatValkeyClusterErrors(foundCluster.Errors))
+
+		// The failed cluster is saved, and marked as tainted by the error, so that it can be inspected and is
+		// replaced by the next apply
+		if plan.RetainOnFailure.ValueBool() && attempt >= retryAttempts {
+			resp.Diagnostics.Append(setValkeyClusterComputedAttributes(ctx, &plan, foundCluster)...)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+			resp.Diagnostics.AddError("Cluster Creation Failed", fmt.Sprintf("%s. The cluster is kept for debugging since retain_on_failure is set, and is replaced by the next apply.", failure))
+			return
+		}
+
+		if err := r.deleteClusterAndPollUntilGone(ctx, &plan); err != nil {
+			resp.Diagnostics.AddError("Cluster Deletion Failed", fmt.Sprintf("%s and an attempt was made to delete it, but deletion failed with error: %s. You may need to manually delete the cluster before attempting another creation.", failure, err.Error()))
+			return
+		}
+		if attempt >= retryAttempts {
+			resp.Diagnostics.AddError("Cluster Creation Failed", fmt.Sprintf("%s and has been deleted. Please try creating the resource again.", failure))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddWarning("Cluster Creation Retried", fmt.Sprintf("%s and has been deleted, creating it again (retry %d of %d).", failure, attempt+1, retryAttempts))
+		tflog.Warn(ctx, "Retrying failed valkey cluster creation", map[string]interface{}{"cluster_name": clusterName, "retry": attempt + 1, "errors": foundCluster.Errors})
+	}
+
+	// Populate the connection details now that the cluster is ready
+	r.refreshComputedAttributes(ctx, &plan, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// POST /ec-cluster
+// Expected response: 2xx.
+func (r *ValkeyClusterResource) requestCluster(requestJson []byte) error {
+	client := *r.httpClient
+	postRequest, err := http.NewRequest("POST", fmt.Sprintf("%s/ec-cluster", r.httpEndpoint), bytes.NewBuffer(requestJson))
+	if err != nil {
+		return err
+	}
+	postRequest.Header.Set("Content-Type", "application/json")
+	postRequest.Header.Set("Authorization", r.httpAuthToken)
+	httpResp, err := client.Do(postRequest)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = httpResp.Body.Close() }()
+	if httpResp.StatusCode >= 300 {
+		body, _ := io.ReadAll(httpResp.Body)
+		return fmt.Errorf("got non-200 response: %s %s", httpResp.Status, string(body))
+	}
+	return nil
+}
+
+// valkeyMaxCreationRetryAttempts bounds creation_retry_attempts, since every attempt must complete within the create timeout.
+const valkeyMaxCreationRetryAttempts = 5
 
-	// Poll until cluster status is "Active"
-	r.pollUntilClusterReady(ctx, plan.ClusterName.ValueString(), resp)
+// formatValkeyClusterErrors appends the errors reported by the API to a sentence, or nothing when there are none.
+func formatValkeyClusterErrors(errors []string) string {
+	if len(errors) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(" with errors: %s", strings.Join(errors, "; "))
+}
+
+// valkeyClusterHealth summarizes the status and errors of a cluster as `failed`, `degraded` or `healthy`.
+func valkeyClusterHealth(cluster *DescribeValkeyClustersResponseData) string {
+	if cluster.Status == "CreationFailed" {
+		return "failed"
+	}
+	if len(cluster.Errors) > 0 {
+		return "degraded"
+	}
+	return "healthy"
 }
 
 func (r *ValkeyClusterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -338,307 +1159,844 @@ func (r *ValkeyClusterResource) Delete(ctx context.Context, req resource.DeleteR
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
-	defer cancel()
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Fail fast rather than leave the object stores that use the cluster without a cache
+	dependents, err := findValkeyClusterDependents(*r.httpClient, state.ClusterName.ValueString(), r.httpEndpoint, r.httpAuthToken)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list the object stores using cluster %s, got error: %s", state.ClusterName.ValueString(), err))
+		return
+	}
+	if len(dependents) > 0 && !state.ForceDestroy.ValueBool() {
+		resp.Diagnostics.AddError("Cluster In Use", fmt.Sprintf("Cluster \"%s\" is used by object stores %s. Destroy them or repoint them to another cluster first, or set force_destroy to delete them along with the cluster.", state.ClusterName.ValueString(), valkeyClusterDependentNames(dependents)))
+		return
+	}
+	for _, dependent := range dependents {
+		if err := deleteObjectStore(*r.httpClient, dependent.Name, r.httpEndpoint, r.httpAuthToken); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete object store %s using cluster %s, got error: %s", dependent.Name, state.ClusterName.ValueString(), err))
+			return
+		}
+		tflog.Info(ctx, "Deleted object store using valkey cluster", map[string]interface{}{"cluster_name": state.ClusterName.ValueString(), "object_store": dependent.Name})
+	}
+
+	if err := r.deleteClusterAndPollUntilGone(ctx, &state); err != nil {
+		resp.Diagnostics.AddError("Cluster Deletion Failed", fmt.Sprintf("Cluster \"%s\" deletion failed with error: %s. You may need to manually delete the cluster.", state.ClusterName.ValueString(), err.Error()))
+		return
+	}
+}
+
+func (r *ValkeyClusterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ValkeyClusterResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Find valkey cluster
+	client := *r.httpClient
+	foundCluster, err := describeValkeyCluster(client, state.ClusterName.ValueString(), r.httpEndpoint, r.httpAuthToken)
+	if err == nil && foundCluster == nil {
+		resp.Diagnostics.AddWarning("Cluster Not Found", fmt.Sprintf("Cluster with name \"%s\" not found, removing from state", state.ClusterName.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to describe valkey cluster, got error: %s", err))
+		return
+	}
+	if foundCluster == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read valkey cluster, cluster with name \"%s\" not found", state.ClusterName.ValueString()))
+		return
+	}
+
+	if len(foundCluster.Errors) > 0 {
+		resp.Diagnostics.AddWarning("Valkey Cluster Error", fmt.Sprintf("Valkey cluster \"%s\" is %s%s. The errors are listed in its errors attribute.", foundCluster.Name, valkeyClusterHealth(foundCluster), formatValkeyClusterErrors(foundCluster.Errors)))
+	}
+
+	state.EffectiveShardPlacements = NewShardPlacementsValueUnknown()
+	state.EngineVersion = types.StringUnknown()
+	state.ParameterGroupName = types.StringUnknown()
+	state.MaintenanceWindow = types.StringUnknown()
+	state.AuthMode = types.StringUnknown()
+	state.TransitEncryption = types.BoolUnknown()
+	state.ConfigurationEndpoint = types.StringUnknown()
+	state.Port = types.Int64Unknown()
+	state.TlsRequired = types.BoolUnknown()
+	state.CreatedAt = types.StringUnknown()
+	resp.Diagnostics.Append(setValkeyClusterComputedAttributes(ctx, &state, foundCluster)...)
+
+	state.Id = types.StringValue(foundCluster.Name)
+	state.ClusterName = types.StringValue(foundCluster.Name)
+	state.NodeInstanceType = types.StringValue(foundCluster.NodeInstanceType)
+	// A queued instance type is kept in state so that it does not show up as a change to make again
+	if foundCluster.PendingModifications != nil && foundCluster.PendingModifications.NodeInstanceType != "" {
+		state.NodeInstanceType = types.StringValue(foundCluster.PendingModifications.NodeInstanceType)
+	}
+	state.ShardCount = types.Int64Value(foundCluster.ShardCount)
+	state.ReplicationFactor = types.Int64Value(foundCluster.ReplicationFactor)
+	state.EnforceShardMultiAz = types.BoolValue(foundCluster.EnforceShardMultiAz)
+	resp.Diagnostics.Append(refreshTags(ctx, foundCluster.Tags, r.defaultTags, &state.Tags, &state.TagsAll)...)
+
+	// shard_placements is only refreshed when it is configured, otherwise the placements are kept in effective_shard_placements.
+	// An imported cluster has no configuration yet, so its placements are read once to be compared with the configuration.
+	imported, diags := req.Private.GetKey(ctx, valkeyClusterImportedKey)
+	resp.Diagnostics.Append(diags...)
+	if state.ShardPlacements != nil || len(imported) > 0 {
+		state.ShardPlacements = shardPlacementsFromResponse(foundCluster)
+	}
+	if len(imported) > 0 {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, valkeyClusterImportedKey, nil)...)
+	}
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ValkeyClusterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform prior state data into the model
+	var currentState ValkeyClusterResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &currentState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read Terraform planned state into the model
+	var plan ValkeyClusterResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, 120*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	if validationErr := validateCreateValkeyClusterTerraformPlan(&plan); validationErr != nil {
+		resp.Diagnostics.AddAttributeError(
+			validationErr.AttributePath,
+			validationErr.Summary,
+			validationErr.Detail,
+		)
+		return
+	}
+
+	// These are checked during plan, but values that were unknown then are only known now
+	if validationErr, _ := validateValkeyClusterUpdate(&currentState, &plan); validationErr != nil {
+		resp.Diagnostics.AddAttributeError(
+			validationErr.AttributePath,
+			validationErr.Summary,
+			validationErr.Detail,
+		)
+		return
+	}
+
+	// Capacity the object stores using the cluster rely on is not removed unless allow_capacity_reduction is set
+	capacityErr, err := checkValkeyClusterCapacityForDependents(*r.httpClient, r.httpEndpoint, r.httpAuthToken, &currentState, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to check the capacity required by the object stores using cluster %s, got error: %s", currentState.ClusterName.ValueString(), err))
+		return
+	}
+	if capacityErr != nil {
+		appendValkeyClusterCapacityDiagnostic(&resp.Diagnostics, capacityErr, plan.AllowCapacityReduction.ValueBool())
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Run the update as a sequence of steps. The prior state reflects the steps completed by an earlier apply, so only
+	// the remaining steps are planned. A step whose API call was accepted before that apply stopped is resumed by
+	// waiting for it rather than issuing it again.
+	issuedStep, diags := getValkeyClusterIssuedUpdateStep(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	steps, diags := r.planUpdateSteps(ctx, &currentState, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	snapshotTaken := false
+	for _, step := range steps {
+		if step.Key == issuedStep {
+			tflog.Info(ctx, "Resuming valkey cluster update step", map[string]interface{}{"cluster_name": currentState.ClusterName.ValueString(), "step": step.Key})
+		} else {
+			if step.Destructive && plan.SnapshotBeforeUpdate.ValueBool() && !snapshotTaken {
+				snapshotName, err := r.snapshotBeforeUpdate(ctx, &plan)
+				if err != nil {
+					resp.Diagnostics.AddError(
+						"Snapshot Before Update Failed",
+						fmt.Sprintf("Error taking snapshot of cluster %s before %s: %s. The step was not issued.", currentState.ClusterName.ValueString(), step.Description, err),
+					)
+					return
+				}
+				tflog.Info(ctx, "Took valkey cluster snapshot before update", map[string]interface{}{"cluster_name": currentState.ClusterName.ValueString(), "snapshot_name": snapshotName})
+				snapshotTaken = true
+			}
+			resp.Diagnostics.Append(setValkeyClusterIssuedUpdateStep(ctx, resp.Private, step.Key)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			if err := step.Apply(); err != nil {
+				resp.Diagnostics.AddError(
+					step.ErrorSummary,
+					fmt.Sprintf("Error %s for cluster %s: %s", step.Description, currentState.ClusterName.ValueString(), err.Error()),
+				)
+				// The step was not accepted, so it is issued again on the next apply
+				resp.Diagnostics.Append(setValkeyClusterIssuedUpdateStep(ctx, resp.Private, "")...)
+				return
+			}
+		}
+
+		if _, err := r.waitUntilClusterActive(ctx, &plan); err != nil {
+			resp.Diagnostics.AddError(
+				"Cluster Update Did Not Complete",
+				fmt.Sprintf("Error waiting for cluster %s after %s: %s. The update will resume from this step on the next apply.", currentState.ClusterName.ValueString(), step.Description, err),
+			)
+			return
+		}
+
+		// Save the completed step so that a later failure does not lose it
+		step.Record(&currentState)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &currentState)...)
+		resp.Diagnostics.Append(setValkeyClusterIssuedUpdateStep(ctx, resp.Private, "")...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Tags are updated on their own, so a tag-only change does not touch the replication group
+	tagsAllValue, diags := tagsAll(ctx, r.defaultTags, plan.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.TagsAll = tagsAllValue
+	if !plan.TagsAll.Equal(currentState.TagsAll) {
+		if err := putResourceTags(*r.httpClient, "ec-cluster/"+currentState.ClusterName.ValueString(), tagsFromMap(plan.TagsAll), r.httpEndpoint, r.httpAuthToken); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update tags of cluster %s, got error: %s", currentState.ClusterName.ValueString(), err))
+			return
+		}
+	}
+
+	// Populate the connection details, which may have changed with the shard layout
+	r.refreshComputedAttributes(ctx, &plan, &resp.Diagnostics)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// valkeyClusterUpdateProgressKey is the private state key that records an update step whose API call was accepted
+// but which has not been seen to complete.
+const valkeyClusterUpdateProgressKey = "update_progress"
+
+type valkeyClusterUpdateProgress struct {
+	IssuedStep string `json:"issued_step"`
+}
+
+// valkeyClusterUpdateStep is one API operation of an update. Each step is followed by waiting for the cluster to
+// become active again before the next one is issued.
+type valkeyClusterUpdateStep struct {
+	// Key identifies the step and the values it applies, so an interrupted step is only resumed for the same change.
+	Key          string
+	Description  string
+	ErrorSummary string
+	// Destructive steps remove shards, replicas or nodes, and are preceded by a snapshot when snapshot_before_update is set.
+	Destructive bool
+	Apply       func() error
+	// Record copies the values applied by the step into the model that is saved once the step completes.
+	Record func(model *ValkeyClusterResourceModel)
+}
+
+// planUpdateSteps computes the ordered steps that take the cluster from its current state to the plan.
+func (r *ValkeyClusterResource) planUpdateSteps(ctx context.Context, currentState *ValkeyClusterResourceModel, plan *ValkeyClusterResourceModel) ([]valkeyClusterUpdateStep, diag.Diagnostics) {
+	clusterName := currentState.ClusterName.ValueString()
+	diff := determineDiff(*currentState, *plan)
+	var steps []valkeyClusterUpdateStep
+	var diags diag.Diagnostics
+
+	// Placements that were not configured are taken from the effective placements, which were generated during plan
+	// when availability_zones is set
+	configuredPlacements := plan.ShardPlacements != nil
+	currentPlacements := currentState.ShardPlacements
+	if currentPlacements == nil {
+		placements, d := shardPlacementsFromList(ctx, currentState.EffectiveShardPlacements)
+		diags.Append(d...)
+		currentPlacements = placements
+	}
+	plannedPlacements := plan.ShardPlacements
+	if plannedPlacements == nil && !plan.AvailabilityZones.IsNull() {
+		placements, d := shardPlacementsFromList(ctx, plan.EffectiveShardPlacements)
+		diags.Append(d...)
+		plannedPlacements = placements
+	}
+	availabilityZones := availabilityZonesOfPlacements(currentPlacements)
+	if !plan.AvailabilityZones.IsNull() {
+		diags.Append(plan.AvailabilityZones.ElementsAs(ctx, &availabilityZones, false)...)
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	// If replication_factor is changing from nonzero to 0, enforce_shard_multi_az must be set to false first.
+	// Else it'll fail with "Invalid Argument: Must have at least 1 replica for Multi-AZ enabled Replication Group"
+	multiAzDisabledFirst := plan.ReplicationFactor.ValueInt64() == 0 && currentState.ReplicationFactor.ValueInt64() > 0 && diff["enforce_shard_multi_az"]
+	if multiAzDisabledFirst {
+		enforceShardMultiAz := false
+		steps = append(steps, valkeyClusterUpdateStep{
+			Key:          "enforce_shard_multi_az:false",
+			Description:  "disabling multi-AZ enforcement",
+			ErrorSummary: "Failed to update replication group",
+			Apply: func() error {
+				return r.updateReplicationGroup(clusterName, valkeyReplicationGroupUpdate{EnforceShardMultiAz: &enforceShardMultiAz, ApplyImmediately: true})
+			},
+			Record: func(model *ValkeyClusterResourceModel) {
+				model.EnforceShardMultiAz = types.BoolValue(enforceShardMultiAz)
+			},
+		})
+	}
+
+	if diff["replication_factor"] {
+		replicationFactor := plan.ReplicationFactor.ValueInt64()
+
+		// If not changing number of shards, then send shard placements as is
+		updatedCurrentShardPlacements := plannedPlacements
+
+		// Else update replication_factor for existing shards first, keeping their primaries and spreading any new
+		// replicas across the availability zones
+		if plan.ShardCount.ValueInt64() != currentState.ShardCount.ValueInt64() && len(currentPlacements) > 0 {
+			updatedCurrentShardPlacements = balanceShardPlacements(currentPlacements, availabilityZones, int64(len(currentPlacements)), replicationFactor, plan.EnforceShardMultiAz.ValueBool())
+		}
+		var updatedEffectivePlacements ShardPlacementsValue
+		if updatedCurrentShardPlacements != nil {
+			list, d := shardPlacementsToList(ctx, updatedCurrentShardPlacements)
+			diags.Append(d...)
+			updatedEffectivePlacements = list
+		}
+
+		step := valkeyClusterUpdateStep{
+			Key: fmt.Sprintf("replication_factor:%d", replicationFactor),
+			Record: func(model *ValkeyClusterResourceModel) {
+				model.ReplicationFactor = types.Int64Value(replicationFactor)
+				if configuredPlacements {
+					model.ShardPlacements = updatedCurrentShardPlacements
+				}
+				if updatedCurrentShardPlacements != nil {
+					model.EffectiveShardPlacements = updatedEffectivePlacements
+				}
+			},
+		}
+		if replicationFactor > currentState.ReplicationFactor.ValueInt64() {
+			step.Description = "increasing replication factor"
+			step.ErrorSummary = "Failed to increase replication factor"
+			step.Apply = func() error {
+				return r.increaseReplicaCount(clusterName, int(replicationFactor), updatedCurrentShardPlacements)
+			}
+		} else {
+			step.Description = "decreasing replication factor"
+			step.Destructive = true
+			step.ErrorSummary = "Failed to decrease replication factor"
+			step.Apply = func() error {
+				return r.decreaseReplicaCount(clusterName, int(replicationFactor), updatedCurrentShardPlacements)
+			}
+		}
+		steps = append(steps, step)
+	}
+
+	if diff["shard_count"] {
+		shardCount := plan.ShardCount.ValueInt64()
+		shardPlacements := plannedPlacements
+		var effectivePlacements ShardPlacementsValue
+		if shardPlacements != nil {
+			list, d := shardPlacementsToList(ctx, shardPlacements)
+			diags.Append(d...)
+			effectivePlacements = list
+		}
+		step := valkeyClusterUpdateStep{
+			Key: fmt.Sprintf("shard_count:%d", shardCount),
+			Record: func(model *ValkeyClusterResourceModel) {
+				model.ShardCount = types.Int64Value(shardCount)
+				if configuredPlacements {
+					model.ShardPlacements = shardPlacements
+				}
+				if shardPlacements != nil {
+					model.EffectiveShardPlacements = effectivePlacements
+				}
+			},
+		}
+		if shardCount > currentState.ShardCount.ValueInt64() {
+			step.Description = "increasing shard count"
+			step.ErrorSummary = "Failed to increase shard count"
+			step.Apply = func() error {
+				// If increasing shard_count and shard_placements was not specified, then pass only shard_count (placements will be nil anyway)
+				err := r.increaseShardCount(clusterName, int(shardCount), shardPlacements)
+				if err != nil && strings.Contains(err.Error(), "Availability zones in node group configuration does not match actual availability zones for existing cache clusters") {
+					return fmt.Errorf("%w. This error can occur when replica or shards end up in AZs that were not specified in the terraform resource. Try calling the describe API on your cluster and updating the terraform resource with the correct AZs, or contact Momento support for assistance", err)
+				}
+				return err
+			}
+		} else {
+			shardsToRemove := shardIndexesToRemove(currentPlacements, shardPlacements, currentState.ShardCount.ValueInt64(), shardCount)
+			step.Description = "decreasing shard count"
+			step.Destructive = true
+			step.ErrorSummary = "Failed to decrease shard count"
+			step.Apply = func() error {
+				return r.decreaseShardCount(clusterName, int(shardCount), shardsToRemove)
+			}
+		}
+		steps = append(steps, step)
+	}
+
+	// The parameter group must match the engine version, so when the version is upgraded the parameter group is
+	// changed along with the final upgrade
+	var parameterGroupName *string
+	if diff["parameter_group_name"] {
+		valueString := plan.ParameterGroupName.ValueString()
+		parameterGroupName = &valueString
+	}
+
+	// Changes that cause failovers are queued for the maintenance window unless they are applied immediately
+	applyImmediately := plan.ApplyImmediately.ValueBool()
+
+	// Regardless of shard_placements, updateReplicationGroup if node_instance_type and/or enforce_shard_multi_az are updated
+	if diff["node_instance_type"] || (diff["enforce_shard_multi_az"] && !multiAzDisabledFirst) || (parameterGroupName != nil && !diff["engine_version"]) || diff["maintenance_window"] || diff["auth_mode"] {
+		update := valkeyReplicationGroupUpdate{ApplyImmediately: applyImmediately}
+		if diff["node_instance_type"] {
+			valueString := plan.NodeInstanceType.ValueString()
+			update.NodeInstanceType = &valueString
+		}
+		if diff["enforce_shard_multi_az"] && !multiAzDisabledFirst {
+			valueBool := plan.EnforceShardMultiAz.ValueBool()
+			update.EnforceShardMultiAz = &valueBool
+		}
+		if !diff["engine_version"] {
+			update.ParameterGroupName = parameterGroupName
+		}
+		if diff["maintenance_window"] {
+			valueString := plan.MaintenanceWindow.ValueString()
+			update.MaintenanceWindow = &valueString
+		}
+		if diff["auth_mode"] {
+			valueString := plan.AuthMode.ValueString()
+			update.AuthMode = &valueString
+		}
+		steps = append(steps, valkeyClusterUpdateStep{
+			Key:          fmt.Sprintf("replication_group:%s:%t:%s:%s:%s:%t", plan.NodeInstanceType.ValueString(), plan.EnforceShardMultiAz.ValueBool(), plan.ParameterGroupName.ValueString(), plan.MaintenanceWindow.ValueString(), plan.AuthMode.ValueString(), applyImmediately),
+			Description:  "updating replication group",
+			ErrorSummary: "Failed to update replication group",
+			// Changing the instance type replaces every node
+			Destructive: update.NodeInstanceType != nil,
+			Apply: func() error {
+				return r.updateReplicationGroup(clusterName, update)
+			},
+			Record: update.record,
+		})
+	}
 
-	if err := r.deleteClusterAndPollUntilGone(ctx, state.ClusterName.ValueString()); err != nil {
-		resp.Diagnostics.AddError("Cluster Deletion Failed", fmt.Sprintf("Cluster \"%s\" deletion failed with error: %s. You may need to manually delete the cluster.", state.ClusterName.ValueString(), err.Error()))
-		return
+	// Upgrade one engine version at a time, waiting for the cluster to become active after each
+	if diff["engine_version"] {
+		upgradePath := valkeyEngineUpgradePath(currentState.EngineVersion.ValueString(), plan.EngineVersion.ValueString())
+		for i, engineVersion := range upgradePath {
+			update := valkeyReplicationGroupUpdate{EngineVersion: &engineVersion, ApplyImmediately: applyImmediately}
+			if i == len(upgradePath)-1 {
+				update.ParameterGroupName = parameterGroupName
+			}
+			steps = append(steps, valkeyClusterUpdateStep{
+				Key:          fmt.Sprintf("engine_version:%s:%t", engineVersion, applyImmediately),
+				Description:  fmt.Sprintf("upgrading engine version to %s", engineVersion),
+				ErrorSummary: "Failed to upgrade engine version",
+				Apply: func() error {
+					return r.updateReplicationGroup(clusterName, update)
+				},
+				Record: update.record,
+			})
+		}
 	}
+
+	return steps, diags
 }
 
-func (r *ValkeyClusterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state ValkeyClusterResourceModel
+// privateStateGetter is implemented by the private state of both requests and responses.
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
 
-	// Read Terraform prior state data into the model
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+// privateStateSetter is implemented by the private state of responses.
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
 
-	if resp.Diagnostics.HasError() {
-		return
+// getValkeyClusterIssuedUpdateStep returns the key of the update step that was issued but not seen to complete.
+func getValkeyClusterIssuedUpdateStep(ctx context.Context, private privateStateGetter) (string, diag.Diagnostics) {
+	value, diags := private.GetKey(ctx, valkeyClusterUpdateProgressKey)
+	if diags.HasError() || len(value) == 0 {
+		return "", diags
 	}
+	var progress valkeyClusterUpdateProgress
+	if err := json.Unmarshal(value, &progress); err != nil {
+		// Progress is only an optimization, so issue every step again rather than fail
+		tflog.Warn(ctx, "Ignoring unreadable valkey cluster update progress", map[string]interface{}{"error": err.Error()})
+		return "", diags
+	}
+	return progress.IssuedStep, diags
+}
 
-	// Find valkey cluster
-	client := *r.httpClient
-	foundCluster, err := describeValkeyCluster(client, state.ClusterName.ValueString(), r.httpEndpoint, r.httpAuthToken)
-	if err == nil && foundCluster == nil {
-		resp.Diagnostics.AddWarning("Cluster Not Found", fmt.Sprintf("Cluster with name \"%s\" not found, removing from state", state.ClusterName.ValueString()))
-		resp.State.RemoveResource(ctx)
-		return
+// setValkeyClusterIssuedUpdateStep records the update step that was issued. An empty key clears the progress.
+func setValkeyClusterIssuedUpdateStep(ctx context.Context, private privateStateSetter, stepKey string) diag.Diagnostics {
+	if stepKey == "" {
+		return private.SetKey(ctx, valkeyClusterUpdateProgressKey, nil)
 	}
+	value, err := json.Marshal(valkeyClusterUpdateProgress{IssuedStep: stepKey})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to describe valkey cluster, got error: %s", err))
-		return
-	}
-	if foundCluster == nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read valkey cluster, cluster with name \"%s\" not found", state.ClusterName.ValueString()))
-		return
+		var diags diag.Diagnostics
+		diags.AddError("Internal Error", fmt.Sprintf("Unable to marshal update progress, got error: %s", err))
+		return diags
 	}
+	return private.SetKey(ctx, valkeyClusterUpdateProgressKey, value)
+}
 
-	if len(foundCluster.Errors) > 0 {
-		resp.Diagnostics.AddWarning("Valkey Cluster Error", fmt.Sprintf("Found valkey cluster \"%s\" with errors: %v", foundCluster.Name, foundCluster.Errors))
+// refreshComputedAttributes describes the cluster and copies its computed attributes into the model. If the cluster
+// cannot be described a warning is added, the planned values are kept and the unknown attributes are set to null,
+// since state must not hold unknowns.
+func (r *ValkeyClusterResource) refreshComputedAttributes(ctx context.Context, model *ValkeyClusterResourceModel, diags *diag.Diagnostics) {
+	foundCluster, err := describeValkeyCluster(*r.httpClient, model.ClusterName.ValueString(), r.httpEndpoint, r.httpAuthToken)
+	if err != nil || foundCluster == nil {
+		diags.AddWarning("Unable to describe valkey cluster", fmt.Sprintf("Unable to read the connection details of cluster \"%s\", they will be populated on the next refresh. Error: %v", model.ClusterName.ValueString(), err))
 	}
+	diags.Append(setValkeyClusterComputedAttributes(ctx, model, foundCluster)...)
+}
 
-	state.Id = types.StringValue(foundCluster.Name)
-	state.ClusterName = types.StringValue(foundCluster.Name)
-	state.NodeInstanceType = types.StringValue(foundCluster.NodeInstanceType)
-	state.ShardCount = types.Int64Value(foundCluster.ShardCount)
-	state.ReplicationFactor = types.Int64Value(foundCluster.ReplicationFactor)
-	state.EnforceShardMultiAz = types.BoolValue(foundCluster.EnforceShardMultiAz)
-
-	// reset the list of shard placements before repopulating from the response
-	state.ShardPlacements = nil
-	for _, sp := range foundCluster.ShardPlacements {
-		state.ShardPlacements = append(state.ShardPlacements, ShardPlacementModel{
-			Index:            types.Int64Value(sp.ShardIndex),
-			AvailabilityZone: types.StringValue(sp.AvailabilityZone),
-			ReplicaAvailabilityZones: func() []types.String {
-				replicaAZs := make([]types.String, len(sp.ReplicaAvailabilityZones))
-				for j, az := range sp.ReplicaAvailabilityZones {
-					replicaAZs[j] = types.StringValue(az)
-				}
-				return replicaAZs
-			}(),
-		})
+// setValkeyClusterComputedAttributes copies the computed attributes from a describe response into the model.
+// Attributes that are kept from state during plan, such as the effective shard placements, engine version, parameter
+// group, maintenance window, authentication settings and connection details, are only set when they are unknown,
+// since values known during plan must be saved as planned. A nil cluster sets the unknown attributes to null and
+// keeps the known ones.
+// Queued changes take precedence over the running engine version and parameter group.
+func setValkeyClusterComputedAttributes(ctx context.Context, model *ValkeyClusterResourceModel, cluster *DescribeValkeyClustersResponseData) diag.Diagnostics {
+	var diags diag.Diagnostics
+	shardType := types.ObjectType{AttrTypes: valkeyClusterShardAttrTypes}
+	if model.EffectiveShardPlacements.IsUnknown() {
+		model.EffectiveShardPlacements = NewShardPlacementsValueNull()
+		if cluster != nil {
+			shardPlacementList, d := shardPlacementsToList(ctx, shardPlacementsFromResponse(cluster))
+			diags.Append(d...)
+			model.EffectiveShardPlacements = shardPlacementList
+		}
 	}
-
-	// Set refreshed state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
-
-	if resp.Diagnostics.HasError() {
-		return
+	if model.EngineVersion.IsUnknown() {
+		model.EngineVersion = types.StringNull()
+		if cluster != nil && cluster.EngineVersion != "" {
+			model.EngineVersion = types.StringValue(cluster.EngineVersion)
+		}
+		if cluster != nil && cluster.PendingModifications != nil && cluster.PendingModifications.EngineVersion != "" {
+			model.EngineVersion = types.StringValue(cluster.PendingModifications.EngineVersion)
+		}
 	}
-}
-
-func (r *ValkeyClusterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Read Terraform prior state data into the model
-	var currentState ValkeyClusterResourceModel
-	resp.Diagnostics.Append(req.State.Get(ctx, &currentState)...)
-	if resp.Diagnostics.HasError() {
-		return
+	if model.ParameterGroupName.IsUnknown() {
+		model.ParameterGroupName = types.StringNull()
+		if cluster != nil && cluster.ParameterGroupName != "" {
+			model.ParameterGroupName = types.StringValue(cluster.ParameterGroupName)
+		}
+		if cluster != nil && cluster.PendingModifications != nil && cluster.PendingModifications.ParameterGroupName != "" {
+			model.ParameterGroupName = types.StringValue(cluster.PendingModifications.ParameterGroupName)
+		}
 	}
-
-	// Read Terraform planned state into the model
-	var plan ValkeyClusterResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
-	if resp.Diagnostics.HasError() {
-		return
+	if model.MaintenanceWindow.IsUnknown() {
+		model.MaintenanceWindow = types.StringNull()
+		if cluster != nil && cluster.MaintenanceWindow != "" {
+			model.MaintenanceWindow = types.StringValue(cluster.MaintenanceWindow)
+		}
 	}
-
-	// Updating name is not allowed since it might be used by an object store, require explicit delete and recreate
-	if plan.ClusterName.ValueString() != currentState.ClusterName.ValueString() {
-		resp.Diagnostics.AddError("Invalid Update", "Updating the cluster name is not allowed. Please manually delete and recreate the resource.")
-		return
+	if model.AuthMode.IsUnknown() {
+		model.AuthMode = types.StringNull()
+		if cluster != nil && cluster.AuthMode != "" {
+			model.AuthMode = types.StringValue(cluster.AuthMode)
+		}
 	}
-
-	updateTimeout, diags := plan.Timeouts.Update(ctx, 120*time.Minute)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+	if model.TransitEncryption.IsUnknown() {
+		model.TransitEncryption = types.BoolNull()
+		if cluster != nil {
+			model.TransitEncryption = types.BoolValue(cluster.TransitEncryption)
+		}
 	}
-	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
-	defer cancel()
-
-	if validationErr := validateCreateValkeyClusterTerraformPlan(&plan); validationErr != nil {
-		resp.Diagnostics.AddAttributeError(
-			validationErr.AttributePath,
-			validationErr.Summary,
-			validationErr.Detail,
-		)
-		return
+	if model.ConfigurationEndpoint.IsUnknown() {
+		model.ConfigurationEndpoint = types.StringNull()
+		if cluster != nil && cluster.ConfigurationEndpoint != nil {
+			model.ConfigurationEndpoint = types.StringValue(cluster.ConfigurationEndpoint.Address)
+		}
 	}
-
-	// Compare requested state with current state and make the appropriate update calls
-	diff := determineDiff(currentState, plan)
-
-	// Updates to shard_placements without accompanying change to shard_count or replication_factor are not allowed,
-	// indicate the resource must be manually deleted and recreated
-	if diff["shard_placements"] && !diff["shard_count"] && !diff["replication_factor"] {
-		resp.Diagnostics.AddError(
-			"Invalid Update",
-			"Updates to shard_placements without accompanying change to shard_count or replication_factor are not allowed. Please manually delete and recreate the resource.",
-		)
-		return
+	if model.Port.IsUnknown() {
+		model.Port = types.Int64Null()
+		if cluster != nil && cluster.ConfigurationEndpoint != nil {
+			model.Port = types.Int64Value(cluster.ConfigurationEndpoint.Port)
+		}
 	}
-
-	// The replica/shard updates may accept shard_placements updates, but not a change in primary AZ for each shard
-	if determineIfShardAZChanged(currentState.ShardPlacements, plan.ShardPlacements) {
-		resp.Diagnostics.AddError(
-			"Invalid Update",
-			"Updates to shard_placements that change the primary AZ for a shard are not allowed. Please manually delete and recreate the resource.",
-		)
-		return
+	if model.TlsRequired.IsUnknown() {
+		model.TlsRequired = types.BoolNull()
+		if cluster != nil {
+			model.TlsRequired = types.BoolValue(cluster.TlsRequired)
+		}
 	}
-
-	// If replication_factor is changing from nonzero to 0, enforce_shard_multi_az must be set to false first.
-	// Else it'll fail with "Invalid Argument: Must have at least 1 replica for Multi-AZ enabled Replication Group"
-	if plan.ReplicationFactor.ValueInt64() == 0 && currentState.ReplicationFactor.ValueInt64() > 0 {
-		// Ask user to update enforce_shard_multi_az accordingly
-		if plan.EnforceShardMultiAz.ValueBool() {
-			resp.Diagnostics.AddError("Invalid Update", "enforce_shard_multi_az must be set to false before setting replication_factor to 0. Please update the resource to set enforce_shard_multi_az to false before retrying")
-			return
+	if model.CreatedAt.IsUnknown() {
+		model.CreatedAt = types.StringNull()
+		if cluster != nil && cluster.CreatedAt != "" {
+			model.CreatedAt = types.StringValue(cluster.CreatedAt)
+		}
+	}
+	if cluster == nil {
+		if model.PendingModifications.IsUnknown() {
+			model.PendingModifications = types.ObjectNull(valkeyClusterPendingModificationsAttrTypes)
+		}
+		if model.Status.IsUnknown() {
+			model.Status = types.StringNull()
+		}
+		if model.Health.IsUnknown() {
+			model.Health = types.StringNull()
+		}
+		if model.Shards.IsUnknown() {
+			model.Shards = types.ListNull(shardType)
+		}
+		if model.Errors.IsUnknown() {
+			model.Errors = types.ListNull(types.StringType)
 		}
+		return diags
+	}
 
-		var enforceShardMultiAz *bool
-		if diff["enforce_shard_multi_az"] {
-			valueBool := plan.EnforceShardMultiAz.ValueBool()
-			enforceShardMultiAz = &valueBool
-			err := r.updateReplicationGroup(currentState.ClusterName.ValueString(), nil, enforceShardMultiAz)
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Failed to update replication group",
-					fmt.Sprintf("Error updating replication group for cluster %s: %s", currentState.ClusterName.ValueString(), err.Error()),
-				)
-				return
+	model.PendingModifications = types.ObjectNull(valkeyClusterPendingModificationsAttrTypes)
+	if cluster.PendingModifications != nil {
+		pending := cluster.PendingModifications
+		pendingObject, d := types.ObjectValue(valkeyClusterPendingModificationsAttrTypes, map[string]attr.Value{
+			"node_instance_type":   optionalStringValue(pending.NodeInstanceType),
+			"engine_version":       optionalStringValue(pending.EngineVersion),
+			"parameter_group_name": optionalStringValue(pending.ParameterGroupName),
+		})
+		diags.Append(d...)
+		model.PendingModifications = pendingObject
+	}
+	model.Status = types.StringValue(cluster.Status)
+	model.Health = types.StringValue(valkeyClusterHealth(cluster))
+
+	shards := make([]ValkeyClusterShardModel, len(cluster.Shards))
+	for i, shard := range cluster.Shards {
+		nodes := make([]ValkeyClusterNodeModel, len(shard.Nodes))
+		for j, node := range shard.Nodes {
+			nodes[j] = ValkeyClusterNodeModel{
+				Address:          types.StringValue(node.Address),
+				Port:             types.Int64Value(node.Port),
+				Role:             types.StringValue(node.Role),
+				AvailabilityZone: types.StringValue(node.AvailabilityZone),
 			}
-			r.pollUntilClusterUpdated(ctx, plan.ClusterName.ValueString(), resp)
+		}
+		shards[i] = ValkeyClusterShardModel{
+			Index: types.Int64Value(shard.ShardIndex),
+			Nodes: nodes,
 		}
 	}
+	shardList, d := types.ListValueFrom(ctx, shardType, shards)
+	diags.Append(d...)
+	model.Shards = shardList
 
-	if diff["replication_factor"] {
-		// If not changing number of shards, then send shard placements as is
-		updatedCurrentShardPlacements := plan.ShardPlacements
-
-		// Else update replication_factor for existing shards first
-		if plan.ShardCount.ValueInt64() != currentState.ShardCount.ValueInt64() {
-			// Make copy of current shard placements to modify for the replication factor update, so that we don't mutate the current state shard placements in case we need to use them for a subsequent shard count update if both replication_factor and shard_count are changing
-			updatedCurrentShardPlacements = make([]ShardPlacementModel, len(currentState.ShardPlacements))
-			for i, sp := range currentState.ShardPlacements {
-				replicaAZs := make([]types.String, len(sp.ReplicaAvailabilityZones))
-				copy(replicaAZs, sp.ReplicaAvailabilityZones)
-				updatedCurrentShardPlacements[i] = ShardPlacementModel{
-					Index:                    sp.Index,
-					AvailabilityZone:         sp.AvailabilityZone,
-					ReplicaAvailabilityZones: replicaAZs,
-				}
-			}
+	errors := cluster.Errors
+	if errors == nil {
+		errors = []string{}
+	}
+	errorList, d := types.ListValueFrom(ctx, types.StringType, errors)
+	diags.Append(d...)
+	model.Errors = errorList
+	return diags
+}
 
-			// if going to 0, make all the shards have empty replica_availability_zones
-			if plan.ReplicationFactor.ValueInt64() == 0 {
-				for i := range updatedCurrentShardPlacements {
-					updatedCurrentShardPlacements[i].ReplicaAvailabilityZones = []types.String{}
-				}
-			} else {
-				// if decreasing replication_factor, trim the number of replica availability zones for each shard to match the new replication factor
-				if plan.ReplicationFactor.ValueInt64() < currentState.ReplicationFactor.ValueInt64() {
-					for i := range updatedCurrentShardPlacements {
-						updatedCurrentShardPlacements[i].ReplicaAvailabilityZones = updatedCurrentShardPlacements[i].ReplicaAvailabilityZones[:plan.ReplicationFactor.ValueInt64()]
-					}
-				} else {
-					// if increasing replication_factor, keep the existing replica availability zones and add new ones in the same AZ as the primary for the new replicas
-					for i := range updatedCurrentShardPlacements {
-						currentReplicaAZs := updatedCurrentShardPlacements[i].ReplicaAvailabilityZones
-						primaryAZ := updatedCurrentShardPlacements[i].AvailabilityZone
-						for j := int64(len(currentReplicaAZs)); j < plan.ReplicationFactor.ValueInt64(); j++ {
-							updatedCurrentShardPlacements[i].ReplicaAvailabilityZones = append(updatedCurrentShardPlacements[i].ReplicaAvailabilityZones, primaryAZ)
-						}
-					}
-				}
-			}
-		}
+// optionalStringValue returns a null string for an empty value.
+func optionalStringValue(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
 
-		// Increase replication factor
-		if plan.ReplicationFactor.ValueInt64() > currentState.ReplicationFactor.ValueInt64() {
-			err := r.increaseReplicaCount(currentState.ClusterName.ValueString(), int(plan.ReplicationFactor.ValueInt64()), updatedCurrentShardPlacements)
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Failed to increase replication factor",
-					fmt.Sprintf("Error increasing replication factor for cluster %s: %s", currentState.ClusterName.ValueString(), err.Error()),
-				)
-				return
+// shardIndexesToRemove returns the indexes of the shards removed on scale-in, which are the current shards missing from
+// the planned placements. Without planned placements the shards with the highest indexes are removed, and without
+// current placements the shards are assumed to be indexed from 0.
+func shardIndexesToRemove(currentPlacements []ShardPlacementModel, plannedPlacements []ShardPlacementModel, currentShardCount int64, shardCount int64) []int {
+	var shardsToRemove []int
+	if plannedPlacements != nil {
+		plannedIndexes := make(map[int64]bool, len(plannedPlacements))
+		for _, sp := range plannedPlacements {
+			plannedIndexes[sp.Index.ValueInt64()] = true
+		}
+		for _, sp := range currentPlacements {
+			if !plannedIndexes[sp.Index.ValueInt64()] {
+				shardsToRemove = append(shardsToRemove, int(sp.Index.ValueInt64()))
 			}
 		}
+		return shardsToRemove
+	}
 
-		// Decrease replication factor
-		if plan.ReplicationFactor.ValueInt64() < currentState.ReplicationFactor.ValueInt64() {
-			err := r.decreaseReplicaCount(currentState.ClusterName.ValueString(), int(plan.ReplicationFactor.ValueInt64()), updatedCurrentShardPlacements)
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Failed to decrease replication factor",
-					fmt.Sprintf("Error decreasing replication factor for cluster %s: %s", currentState.ClusterName.ValueString(), err.Error()),
-				)
-				return
-			}
+	currentIndexes := make([]int, 0, currentShardCount)
+	for _, sp := range currentPlacements {
+		currentIndexes = append(currentIndexes, int(sp.Index.ValueInt64()))
+	}
+	if len(currentIndexes) == 0 {
+		for i := 0; i < int(currentShardCount); i++ {
+			currentIndexes = append(currentIndexes, i)
 		}
+	}
+	sort.Ints(currentIndexes)
+	if len(currentIndexes) > int(shardCount) {
+		shardsToRemove = currentIndexes[shardCount:]
+	}
+	return shardsToRemove
+}
 
-		r.pollUntilClusterUpdated(ctx, plan.ClusterName.ValueString(), resp)
+// balanceShardPlacements generates shard placements across the availability zones. Existing shards keep their
+// placements so that no primary moves, and on scale-in the shards with the highest indexes are removed. New shards
+// take the zone with the fewest primaries, so primaries are spread round-robin. New replicas take the zone with the
+// fewest nodes of their shard and then the fewest replicas overall, never their primary's zone when multiAz is set.
+func balanceShardPlacements(existing []ShardPlacementModel, availabilityZones []string, shardCount int64, replicationFactor int64, multiAz bool) []ShardPlacementModel {
+	kept := make([]ShardPlacementModel, len(existing))
+	copy(kept, existing)
+	sort.Slice(kept, func(i, j int) bool { return kept[i].Index.ValueInt64() < kept[j].Index.ValueInt64() })
+	if int64(len(kept)) > shardCount {
+		kept = kept[:shardCount]
+	}
+
+	placements := make([]ShardPlacementModel, 0, shardCount)
+	primaries := make(map[string]int)
+	replicas := make(map[string]int)
+	nextIndex := int64(0)
+	for _, sp := range kept {
+		replicaAZs := make([]types.String, len(sp.ReplicaAvailabilityZones))
+		copy(replicaAZs, sp.ReplicaAvailabilityZones)
+		if int64(len(replicaAZs)) > replicationFactor {
+			replicaAZs = replicaAZs[:replicationFactor]
+		}
+		for _, az := range replicaAZs {
+			replicas[az.ValueString()]++
+		}
+		primaries[sp.AvailabilityZone.ValueString()]++
+		nextIndex = max(nextIndex, sp.Index.ValueInt64()+1)
+		placements = append(placements, ShardPlacementModel{
+			Index:                    sp.Index,
+			AvailabilityZone:         sp.AvailabilityZone,
+			ReplicaAvailabilityZones: replicaAZs,
+		})
 	}
 
-	if diff["shard_count"] {
-		// Increase shard count
-		if plan.ShardCount.ValueInt64() > currentState.ShardCount.ValueInt64() {
-			// If increasing shard_count and shard_placements was not specified, then pass only shard_count (placements will be nil anyway)
-			err := r.increaseShardCount(currentState.ClusterName.ValueString(), int(plan.ShardCount.ValueInt64()), plan.ShardPlacements)
-			if err != nil {
-				if strings.Contains(err.Error(), "Availability zones in node group configuration does not match actual availability zones for existing cache clusters") {
-					resp.Diagnostics.AddError(
-						"Failed to increase shard count due to AZ mismatch",
-						fmt.Sprintf("Error increasing shard count for cluster %s: %s. This error can occur when replica or shards end up in AZs that were not specified in the terraform resource. Try calling the describe API on your cluster and updating the terraform resource with the correct AZs, or contact Momento support for assistance.", currentState.ClusterName.ValueString(), err.Error()),
-					)
-					return
-				}
-				resp.Diagnostics.AddError(
-					"Failed to increase shard count",
-					fmt.Sprintf("Error increasing shard count for cluster %s: %s", currentState.ClusterName.ValueString(), err.Error()),
-				)
-				return
+	for int64(len(placements)) < shardCount {
+		primaryAZ := availabilityZones[0]
+		for _, az := range availabilityZones {
+			if primaries[az] < primaries[primaryAZ] {
+				primaryAZ = az
 			}
 		}
+		primaries[primaryAZ]++
+		placements = append(placements, ShardPlacementModel{
+			Index:                    types.Int64Value(nextIndex),
+			AvailabilityZone:         types.StringValue(primaryAZ),
+			ReplicaAvailabilityZones: []types.String{},
+		})
+		nextIndex++
+	}
 
-		// Decrease shard count
-		if plan.ShardCount.ValueInt64() < currentState.ShardCount.ValueInt64() {
-			// If decreasing shard_count and shard_placements weren't specified, then pass the indexes of the shards to remove based on the difference between current and planned shard counts
-			if len(plan.ShardPlacements) == 0 {
-				resp.Diagnostics.AddError("Specify decrease shard_count shard_placements", "When decreasing shard_count, shard_placements must also be specified to indicate which shards to remove. Please update the plan to include shard_placements with the indexes of the shards to remove.")
-				return
-			}
-			// Calculate which shard indexes to remove based on the difference between current and planned shard placements
-			plannedIndexes := make(map[int64]bool, len(plan.ShardPlacements))
-			for _, sp := range plan.ShardPlacements {
-				plannedIndexes[sp.Index.ValueInt64()] = true
+	for i := range placements {
+		primaryAZ := placements[i].AvailabilityZone.ValueString()
+		inShard := map[string]int{primaryAZ: 1}
+		for _, az := range placements[i].ReplicaAvailabilityZones {
+			inShard[az.ValueString()]++
+		}
+		// Ties are broken in zone order starting after the primary's zone
+		start := 0
+		for j, az := range availabilityZones {
+			if az == primaryAZ {
+				start = j + 1
 			}
-			var shardsToRemove []int
-			for _, sp := range currentState.ShardPlacements {
-				if !plannedIndexes[sp.Index.ValueInt64()] {
-					shardsToRemove = append(shardsToRemove, int(sp.Index.ValueInt64()))
+		}
+		for int64(len(placements[i].ReplicaAvailabilityZones)) < replicationFactor {
+			replicaAZ := ""
+			for j := range availabilityZones {
+				az := availabilityZones[(start+j)%len(availabilityZones)]
+				if multiAz && az == primaryAZ {
+					continue
+				}
+				if replicaAZ == "" || inShard[az] < inShard[replicaAZ] || (inShard[az] == inShard[replicaAZ] && replicas[az] < replicas[replicaAZ]) {
+					replicaAZ = az
 				}
 			}
-			if err := r.decreaseShardCount(currentState.ClusterName.ValueString(), int(plan.ShardCount.ValueInt64()), shardsToRemove); err != nil {
-				resp.Diagnostics.AddError(
-					"Failed to decrease shard count",
-					fmt.Sprintf("Error decreasing shard count for cluster %s: %s", currentState.ClusterName.ValueString(), err.Error()),
-				)
-				return
+			if replicaAZ == "" {
+				replicaAZ = primaryAZ
 			}
+			inShard[replicaAZ]++
+			replicas[replicaAZ]++
+			placements[i].ReplicaAvailabilityZones = append(placements[i].ReplicaAvailabilityZones, types.StringValue(replicaAZ))
 		}
-
-		r.pollUntilClusterUpdated(ctx, plan.ClusterName.ValueString(), resp)
 	}
+	return placements
+}
 
-	// Regardless of shard_placements, updateReplicationGroup if node_instance_type and/or enforce_shard_multi_az are updated
-	if diff["node_instance_type"] || diff["enforce_shard_multi_az"] {
-		var nodeInstanceType *string
-		if diff["node_instance_type"] {
-			valueString := plan.NodeInstanceType.ValueString()
-			nodeInstanceType = &valueString
+// availabilityZonesOfPlacements returns the distinct availability zones used by the placements, in order of first use.
+func availabilityZonesOfPlacements(shardPlacements []ShardPlacementModel) []string {
+	var availabilityZones []string
+	seen := make(map[string]bool)
+	add := func(az types.String) {
+		if !az.IsNull() && !seen[az.ValueString()] {
+			seen[az.ValueString()] = true
+			availabilityZones = append(availabilityZones, az.ValueString())
 		}
-		var enforceShardMultiAz *bool
-		if diff["enforce_shard_multi_az"] {
-			valueBool := plan.EnforceShardMultiAz.ValueBool()
-			enforceShardMultiAz = &valueBool
+	}
+	for _, sp := range shardPlacements {
+		add(sp.AvailabilityZone)
+	}
+	for _, sp := range shardPlacements {
+		for _, az := range sp.ReplicaAvailabilityZones {
+			add(az)
 		}
-		err := r.updateReplicationGroup(currentState.ClusterName.ValueString(), nodeInstanceType, enforceShardMultiAz)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Failed to update replication group",
-				fmt.Sprintf("Error updating replication group for cluster %s: %s", currentState.ClusterName.ValueString(), err.Error()),
-			)
-			return
+	}
+	return availabilityZones
+}
+
+func shardPlacementsFromResponse(cluster *DescribeValkeyClustersResponseData) []ShardPlacementModel {
+	shardPlacements := make([]ShardPlacementModel, len(cluster.ShardPlacements))
+	for i, sp := range cluster.ShardPlacements {
+		replicaAZs := make([]types.String, len(sp.ReplicaAvailabilityZones))
+		for j, az := range sp.ReplicaAvailabilityZones {
+			replicaAZs[j] = types.StringValue(az)
+		}
+		shardPlacements[i] = ShardPlacementModel{
+			Index:                    types.Int64Value(sp.ShardIndex),
+			AvailabilityZone:         types.StringValue(sp.AvailabilityZone),
+			ReplicaAvailabilityZones: replicaAZs,
 		}
-		r.pollUntilClusterUpdated(ctx, plan.ClusterName.ValueString(), resp)
 	}
+	return shardPlacements
+}
 
-	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+func shardPlacementsToList(ctx context.Context, shardPlacements []ShardPlacementModel) (ShardPlacementsValue, diag.Diagnostics) {
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: valkeyClusterShardPlacementAttrTypes}, shardPlacements)
+	return ShardPlacementsValue{ListValue: list}, diags
+}
+
+// shardPlacementsFromList returns nil for a null or unknown list.
+func shardPlacementsFromList(ctx context.Context, list ShardPlacementsValue) ([]ShardPlacementModel, diag.Diagnostics) {
+	if list.IsNull() || list.IsUnknown() {
+		return nil, nil
+	}
+	shardPlacements := []ShardPlacementModel{}
+	diags := list.ElementsAs(ctx, &shardPlacements, false)
+	return shardPlacements, diags
 }
 
 func shardPlacementsToAPIFormat(shardPlacements []ShardPlacementModel) []map[string]interface{} {
@@ -657,30 +2015,6 @@ func shardPlacementsToAPIFormat(shardPlacements []ShardPlacementModel) []map[str
 	return placements
 }
 
-func determineIfShardAZChanged(currentShards []ShardPlacementModel, plannedShards []ShardPlacementModel) bool {
-	// Compare primary availability zones by shard index so that changes are
-	// detected even when the number of shards changes or their order differs.
-	currentByIndex := make(map[int64]string, len(currentShards))
-	for _, shard := range currentShards {
-		currentByIndex[shard.Index.ValueInt64()] = shard.AvailabilityZone.ValueString()
-	}
-
-	plannedByIndex := make(map[int64]string, len(plannedShards))
-	for _, shard := range plannedShards {
-		plannedByIndex[shard.Index.ValueInt64()] = shard.AvailabilityZone.ValueString()
-	}
-
-	// Only compare shards that exist in both current and planned states.
-	for idx, currentAZ := range currentByIndex {
-		if plannedAZ, ok := plannedByIndex[idx]; ok {
-			if currentAZ != plannedAZ {
-				return true
-			}
-		}
-	}
-	return false
-}
-
 func determineDiff(currentState ValkeyClusterResourceModel, plan ValkeyClusterResourceModel) map[string]bool {
 	diff := make(map[string]bool)
 	if currentState.ShardCount.ValueInt64() != plan.ShardCount.ValueInt64() {
@@ -695,38 +2029,87 @@ func determineDiff(currentState ValkeyClusterResourceModel, plan ValkeyClusterRe
 	if currentState.EnforceShardMultiAz.ValueBool() != plan.EnforceShardMultiAz.ValueBool() {
 		diff["enforce_shard_multi_az"] = true
 	}
-	if currentState.ShardPlacements == nil && plan.ShardPlacements != nil {
-		diff["shard_placements"] = true
-	} else if currentState.ShardPlacements != nil && plan.ShardPlacements == nil {
+	if !plan.EngineVersion.IsUnknown() && currentState.EngineVersion.ValueString() != plan.EngineVersion.ValueString() {
+		diff["engine_version"] = true
+	}
+	if !plan.ParameterGroupName.IsUnknown() && currentState.ParameterGroupName.ValueString() != plan.ParameterGroupName.ValueString() {
+		diff["parameter_group_name"] = true
+	}
+	if !plan.MaintenanceWindow.IsUnknown() && currentState.MaintenanceWindow.ValueString() != plan.MaintenanceWindow.ValueString() {
+		diff["maintenance_window"] = true
+	}
+	if !plan.AuthMode.IsUnknown() && currentState.AuthMode.ValueString() != plan.AuthMode.ValueString() {
+		diff["auth_mode"] = true
+	}
+	// Placements that are not configured are not managed, so adding or removing shard_placements is not a change.
+	// Placements are matched by index, so reordering them is not a change either.
+	if currentState.ShardPlacements != nil && plan.ShardPlacements != nil && !shardPlacementsEqual(currentState.ShardPlacements, plan.ShardPlacements) {
 		diff["shard_placements"] = true
-	} else if currentState.ShardPlacements != nil && plan.ShardPlacements != nil {
-		if len(currentState.ShardPlacements) != len(plan.ShardPlacements) {
-			diff["shard_placements"] = true
-		} else {
-			for i := range currentState.ShardPlacements {
-				if currentState.ShardPlacements[i].Index.ValueInt64() != plan.ShardPlacements[i].Index.ValueInt64() ||
-					currentState.ShardPlacements[i].AvailabilityZone.ValueString() != plan.ShardPlacements[i].AvailabilityZone.ValueString() ||
-					!reflect.DeepEqual(currentState.ShardPlacements[i].ReplicaAvailabilityZones, plan.ShardPlacements[i].ReplicaAvailabilityZones) {
-					diff["shard_placements"] = true
-					break
-				}
-			}
-		}
 	}
 	return diff
 }
 
+// valkeyReplicationGroupUpdate holds the replication group settings to change, nil fields are left unchanged.
+type valkeyReplicationGroupUpdate struct {
+	NodeInstanceType    *string
+	EnforceShardMultiAz *bool
+	EngineVersion       *string
+	ParameterGroupName  *string
+	MaintenanceWindow   *string
+	AuthMode            *string
+	// ApplyImmediately is false to queue the node instance type, engine version and parameter group changes for the
+	// maintenance window.
+	ApplyImmediately bool
+}
+
+// record copies the changed settings into the model.
+func (u valkeyReplicationGroupUpdate) record(model *ValkeyClusterResourceModel) {
+	if u.NodeInstanceType != nil {
+		model.NodeInstanceType = types.StringValue(*u.NodeInstanceType)
+	}
+	if u.EnforceShardMultiAz != nil {
+		model.EnforceShardMultiAz = types.BoolValue(*u.EnforceShardMultiAz)
+	}
+	if u.EngineVersion != nil {
+		model.EngineVersion = types.StringValue(*u.EngineVersion)
+	}
+	if u.ParameterGroupName != nil {
+		model.ParameterGroupName = types.StringValue(*u.ParameterGroupName)
+	}
+	if u.MaintenanceWindow != nil {
+		model.MaintenanceWindow = types.StringValue(*u.MaintenanceWindow)
+	}
+	if u.AuthMode != nil {
+		model.AuthMode = types.StringValue(*u.AuthMode)
+	}
+}
+
 // POST /ec-cluster/<cluster-name>/replication-group
-// Optional fields: node_instance_type, enforce_shard_multi_az
+// Optional fields: node_instance_type, enforce_shard_multi_az, engine_version, parameter_group_name, maintenance_window,
+// auth_mode
+// Required fields: apply_immediately
 // Expected response: 202 Accepted.
-func (r *ValkeyClusterResource) updateReplicationGroup(clusterName string, nodeInstanceType *string, enforceShardMultiAz *bool) error {
+func (r *ValkeyClusterResource) updateReplicationGroup(clusterName string, update valkeyReplicationGroupUpdate) error {
 	requestMap := map[string]interface{}{}
-	if nodeInstanceType != nil {
-		requestMap["node_instance_type"] = *nodeInstanceType
+	if update.NodeInstanceType != nil {
+		requestMap["node_instance_type"] = *update.NodeInstanceType
+	}
+	if update.EnforceShardMultiAz != nil {
+		requestMap["enforce_shard_multi_az"] = *update.EnforceShardMultiAz
+	}
+	if update.EngineVersion != nil {
+		requestMap["engine_version"] = *update.EngineVersion
 	}
-	if enforceShardMultiAz != nil {
-		requestMap["enforce_shard_multi_az"] = *enforceShardMultiAz
+	if update.ParameterGroupName != nil {
+		requestMap["parameter_group_name"] = *update.ParameterGroupName
 	}
+	if update.MaintenanceWindow != nil {
+		requestMap["maintenance_window"] = *update.MaintenanceWindow
+	}
+	if update.AuthMode != nil {
+		requestMap["auth_mode"] = *update.AuthMode
+	}
+	requestMap["apply_immediately"] = update.ApplyImmediately
 
 	requestJson, err := json.Marshal(requestMap)
 	if err != nil {
@@ -905,7 +2288,8 @@ func (r *ValkeyClusterResource) decreaseReplicaCount(clusterName string, replica
 	return nil
 }
 
-func (r *ValkeyClusterResource) deleteClusterAndPollUntilGone(ctx context.Context, clusterName string) error {
+func (r *ValkeyClusterResource) deleteClusterAndPollUntilGone(ctx context.Context, model *ValkeyClusterResourceModel) error {
+	clusterName := model.ClusterName.ValueString()
 	client := *r.httpClient
 	deleteRequest, err := http.NewRequest("DELETE", fmt.Sprintf("%s/ec-cluster/%s", r.httpEndpoint, clusterName), nil)
 	if err != nil {
@@ -922,83 +2306,75 @@ func (r *ValkeyClusterResource) deleteClusterAndPollUntilGone(ctx context.Contex
 	}
 
 	// Poll until the cluster is confirmed deleted (404)
-	// There may be transient server errors during cluster deletion, so ignore non-404 errors and keep polling
-	ticker := time.NewTicker(1 * time.Minute)
-	defer ticker.Stop()
-	for {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		case <-ticker.C:
-			describeRequest, err := http.NewRequest("GET", fmt.Sprintf("%s/ec-cluster/%s", r.httpEndpoint, clusterName), nil)
-			if err != nil {
-				continue
-			}
-			describeRequest.Header.Set("Authorization", r.httpAuthToken)
-			describeResp, err := client.Do(describeRequest)
-			if describeResp != nil && describeResp.Body != nil {
-				_ = describeResp.Body.Close()
-				if err == nil && describeResp.StatusCode == 404 {
-					return nil
-				}
-			}
+	_, err = valkeyClusterWaiter(model, fmt.Sprintf("valkey cluster %q to be deleted", clusterName)).Wait(ctx, func(ctx context.Context) (string, bool, error) {
+		foundCluster, err := describeValkeyCluster(client, clusterName, r.httpEndpoint, r.httpAuthToken)
+		if err != nil {
+			return "", false, err
 		}
-	}
+		if foundCluster == nil {
+			return "Deleted", true, nil
+		}
+		return foundCluster.Status, false, nil
+	})
+	return err
 }
 
-func (r *ValkeyClusterResource) pollUntilClusterReady(ctx context.Context, clusterName string, resp *resource.CreateResponse) {
-	// Poll until cluster status is "Active" or "CreationFailed", log any other errors but do not stop polling
-	ticker := time.NewTicker(1 * time.Minute)
-	defer ticker.Stop()
-	for {
-		select {
-		case <-ctx.Done():
-			// Context has been cancelled, stop polling
-			return
-		case <-ticker.C:
-			foundCluster, err := describeValkeyCluster(*r.httpClient, clusterName, r.httpEndpoint, r.httpAuthToken)
-			if foundCluster != nil && foundCluster.Status == "Active" {
-				return
-			} else if foundCluster != nil && foundCluster.Status == "CreationFailed" {
-				if err := r.deleteClusterAndPollUntilGone(ctx, clusterName); err != nil {
-					resp.Diagnostics.AddError("Cluster Deletion Failed", fmt.Sprintf("Cluster \"%s\" failed to create and an attempt was made to delete it, but deletion failed with error: %s. You may need to manually delete the cluster before attempting another creation.", clusterName, err.Error()))
-					return
-				}
-				resp.Diagnostics.AddError("Cluster Creation Failed", fmt.Sprintf("Cluster \"%s\" failed to create and has been deleted. Please try creating the resource again.", clusterName))
-				resp.State.RemoveResource(ctx)
-				return
-			} else if err == nil && foundCluster == nil {
-				// cluster not found, which could be a transient state during creation before the cluster is fully registered, keep polling
-			} else if err != nil || foundCluster == nil {
-				resp.Diagnostics.AddWarning("Describe after create error", fmt.Sprintf("Error: %s. Continuing to poll until cluster status is Active", err))
-			}
-		}
+// waitUntilClusterActive polls until the cluster status is "Active" or "CreationFailed" and returns the status.
+func (r *ValkeyClusterResource) waitUntilClusterActive(ctx context.Context, model *ValkeyClusterResourceModel) (string, error) {
+	foundCluster, err := waitUntilValkeyClusterActive(ctx, *r.httpClient, model.ClusterName.ValueString(), r.httpEndpoint, r.httpAuthToken, pollIntervalFromConfig(model.PollInterval))
+	if err != nil {
+		return "", err
 	}
+	return foundCluster.Status, nil
 }
 
-func (r *ValkeyClusterResource) pollUntilClusterUpdated(ctx context.Context, clusterName string, resp *resource.UpdateResponse) {
-	// Poll until cluster status is "Active", log any other errors but do not stop polling
-	ticker := time.NewTicker(1 * time.Minute)
-	defer ticker.Stop()
-	for {
-		select {
-		case <-ctx.Done():
-			// Context has been cancelled, stop polling
-			return
-		case <-ticker.C:
-			foundCluster, err := describeValkeyCluster(*r.httpClient, clusterName, r.httpEndpoint, r.httpAuthToken)
-			if foundCluster != nil && foundCluster.Status == "Active" {
-				return
-			} else if err != nil || foundCluster == nil {
-				resp.Diagnostics.AddWarning("Describe after update error", fmt.Sprintf("Error: %s. Continuing to poll until cluster status is Active", err))
-			}
+// waitUntilValkeyClusterActive polls until the cluster status is "Active" or "CreationFailed" and returns the cluster
+// as last described.
+func waitUntilValkeyClusterActive(ctx context.Context, client http.Client, clusterName string, httpEndpoint string, httpAuthToken string, pollInterval time.Duration) (*DescribeValkeyClustersResponseData, error) {
+	var foundCluster *DescribeValkeyClustersResponseData
+	w := waiter{Description: fmt.Sprintf("valkey cluster %q to become active", clusterName), PollInterval: pollInterval}
+	_, err := w.Wait(ctx, func(ctx context.Context) (string, bool, error) {
+		var err error
+		foundCluster, err = describeValkeyCluster(client, clusterName, httpEndpoint, httpAuthToken)
+		if err != nil {
+			return "", false, err
+		}
+		if foundCluster == nil {
+			// cluster not found, which could be a transient state during creation before the cluster is fully registered, keep polling
+			return "NotFound", false, nil
 		}
+		return foundCluster.Status, foundCluster.Status == "Active" || foundCluster.Status == "CreationFailed", nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return foundCluster, nil
+}
 
+// snapshotBeforeUpdate snapshots the cluster and waits for the snapshot to become available, returning its name.
+func (r *ValkeyClusterResource) snapshotBeforeUpdate(ctx context.Context, model *ValkeyClusterResourceModel) (string, error) {
+	clusterName := model.ClusterName.ValueString()
+	snapshotName := fmt.Sprintf("%s-pre-update-%s", clusterName, time.Now().UTC().Format("20060102150405"))
+	if err := createValkeyClusterSnapshot(*r.httpClient, clusterName, snapshotName, r.httpEndpoint, r.httpAuthToken); err != nil {
+		return "", err
 	}
+	if _, err := waitUntilValkeyClusterSnapshotAvailable(ctx, *r.httpClient, snapshotName, r.httpEndpoint, r.httpAuthToken, pollIntervalFromConfig(model.PollInterval)); err != nil {
+		return "", fmt.Errorf("snapshot %q: %w", snapshotName, err)
+	}
+	return snapshotName, nil
+}
+
+// valkeyClusterWaiter returns a waiter polling at the poll_interval of the model.
+func valkeyClusterWaiter(model *ValkeyClusterResourceModel, description string) waiter {
+	return waiter{Description: description, PollInterval: pollIntervalFromConfig(model.PollInterval)}
 }
 
+// valkeyClusterImportedKey is the private state key that marks a cluster imported but not yet read.
+const valkeyClusterImportedKey = "imported"
+
 func (r *ValkeyClusterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("cluster_name"), req, resp)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, valkeyClusterImportedKey, []byte("true"))...)
 }
 
 type DescribeValkeyClustersResponseData struct {
@@ -1007,13 +2383,40 @@ type DescribeValkeyClustersResponseData struct {
 	ShardCount          int64  `json:"shard_count"`
 	ReplicationFactor   int64  `json:"replication_factor"`
 	EnforceShardMultiAz bool   `json:"enforce_shard_multi_az"`
-	ShardPlacements     []struct {
+	EngineVersion       string `json:"engine_version"`
+	ParameterGroupName  string `json:"parameter_group_name"`
+	MaintenanceWindow   string `json:"maintenance_window"`
+	AuthMode            string `json:"auth_mode"`
+	TransitEncryption   bool   `json:"transit_encryption"`
+	// PendingModifications lists the changes queued for the maintenance window, nil when there are none
+	PendingModifications *struct {
+		NodeInstanceType   string `json:"node_instance_type"`
+		EngineVersion      string `json:"engine_version"`
+		ParameterGroupName string `json:"parameter_group_name"`
+	} `json:"pending_modifications"`
+	ShardPlacements []struct {
 		ShardIndex               int64    `json:"shard_index"`
 		AvailabilityZone         string   `json:"availability_zone"`
 		ReplicaAvailabilityZones []string `json:"replica_availability_zones"`
 	} `json:"shard_placements"`
-	Status string   `json:"status"`
-	Errors []string `json:"errors"`
+	Status                string `json:"status"`
+	ConfigurationEndpoint *struct {
+		Address string `json:"address"`
+		Port    int64  `json:"port"`
+	} `json:"configuration_endpoint"`
+	TlsRequired bool `json:"tls_required"`
+	Shards      []struct {
+		ShardIndex int64 `json:"shard_index"`
+		Nodes      []struct {
+			Address          string `json:"address"`
+			Port             int64  `json:"port"`
+			Role             string `json:"role"`
+			AvailabilityZone string `json:"availability_zone"`
+		} `json:"nodes"`
+	} `json:"shards"`
+	Tags      map[string]string `json:"tags"`
+	CreatedAt string            `json:"created_at"`
+	Errors    []string          `json:"errors"`
 }
 
 func describeValkeyCluster(client http.Client, name string, httpEndpoint string, httpAuthToken string) (*DescribeValkeyClustersResponseData, error) {
@@ -0,0 +1,502 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func testDescribedValkeyCluster(t *testing.T, describeJson string) *DescribeValkeyClustersResponseData {
+	t.Helper()
+	var cluster DescribeValkeyClustersResponseData
+	if err := json.Unmarshal([]byte(describeJson), &cluster); err != nil {
+		t.Fatal(err)
+	}
+	return &cluster
+}
+
+// testPlannedValkeyClusterUpdate returns a model as planned for an update: the attributes kept from state are known
+// and the others are unknown.
+func testPlannedValkeyClusterUpdate() ValkeyClusterResourceModel {
+	return ValkeyClusterResourceModel{
+		EffectiveShardPlacements: NewShardPlacementsValueNull(),
+		EngineVersion:            types.StringValue("8.0"),
+		ParameterGroupName:       types.StringValue("default.valkey8"),
+		MaintenanceWindow:        types.StringValue("sun:05:00-sun:06:00"),
+		AuthMode:                 types.StringValue("none"),
+		TransitEncryption:        types.BoolValue(true),
+		ConfigurationEndpoint:    types.StringValue("planned.example.com"),
+		Port:                     types.Int64Value(6379),
+		TlsRequired:              types.BoolValue(true),
+		CreatedAt:                types.StringValue("2026-01-01T00:00:00Z"),
+		PendingModifications:     types.ObjectUnknown(valkeyClusterPendingModificationsAttrTypes),
+		Status:                   types.StringUnknown(),
+		Health:                   types.StringUnknown(),
+		Shards:                   types.ListUnknown(types.ObjectType{AttrTypes: valkeyClusterShardAttrTypes}),
+		Errors:                   types.ListUnknown(types.StringType),
+	}
+}
+
+func TestSetValkeyClusterComputedAttributesKeepsPlannedValues(t *testing.T) {
+	ctx := context.Background()
+	model := testPlannedValkeyClusterUpdate()
+	cluster := testDescribedValkeyCluster(t, `{
+		"name": "test",
+		"status": "Active",
+		"configuration_endpoint": {"address": "described.example.com", "port": 6380},
+		"tls_required": false,
+		"created_at": "2026-02-02T00:00:00Z"
+	}`)
+
+	if diags := setValkeyClusterComputedAttributes(ctx, &model, cluster); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if got := model.ConfigurationEndpoint.ValueString(); got != "planned.example.com" {
+		t.Errorf("configuration_endpoint = %q, want the planned value", got)
+	}
+	if got := model.Port.ValueInt64(); got != 6379 {
+		t.Errorf("port = %d, want the planned value", got)
+	}
+	if !model.TlsRequired.ValueBool() {
+		t.Errorf("tls_required = false, want the planned value")
+	}
+	if got := model.CreatedAt.ValueString(); got != "2026-01-01T00:00:00Z" {
+		t.Errorf("created_at = %q, want the planned value", got)
+	}
+	if got := model.Status.ValueString(); got != "Active" {
+		t.Errorf("status = %q, want the described value", got)
+	}
+	if model.Shards.IsUnknown() || model.Errors.IsUnknown() || model.PendingModifications.IsUnknown() {
+		t.Errorf("unknown attributes were not set from the described cluster")
+	}
+}
+
+func TestSetValkeyClusterComputedAttributesFillsUnknownValues(t *testing.T) {
+	ctx := context.Background()
+	model := testPlannedValkeyClusterUpdate()
+	model.ConfigurationEndpoint = types.StringUnknown()
+	model.Port = types.Int64Unknown()
+	model.TlsRequired = types.BoolUnknown()
+	model.CreatedAt = types.StringUnknown()
+	cluster := testDescribedValkeyCluster(t, `{
+		"name": "test",
+		"status": "Active",
+		"configuration_endpoint": {"address": "described.example.com", "port": 6380},
+		"tls_required": false,
+		"created_at": "2026-02-02T00:00:00Z"
+	}`)
+
+	if diags := setValkeyClusterComputedAttributes(ctx, &model, cluster); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if got := model.ConfigurationEndpoint.ValueString(); got != "described.example.com" {
+		t.Errorf("configuration_endpoint = %q, want the described value", got)
+	}
+	if got := model.Port.ValueInt64(); got != 6380 {
+		t.Errorf("port = %d, want the described value", got)
+	}
+	if model.TlsRequired.IsUnknown() || model.TlsRequired.ValueBool() {
+		t.Errorf("tls_required = %v, want the described value", model.TlsRequired)
+	}
+	if got := model.CreatedAt.ValueString(); got != "2026-02-02T00:00:00Z" {
+		t.Errorf("created_at = %q, want the described value", got)
+	}
+}
+
+func TestSetValkeyClusterComputedAttributesWithoutCluster(t *testing.T) {
+	ctx := context.Background()
+	model := testPlannedValkeyClusterUpdate()
+
+	if diags := setValkeyClusterComputedAttributes(ctx, &model, nil); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if got := model.ConfigurationEndpoint.ValueString(); got != "planned.example.com" {
+		t.Errorf("configuration_endpoint = %q, want the planned value", got)
+	}
+	if got := model.Port.ValueInt64(); got != 6379 {
+		t.Errorf("port = %d, want the planned value", got)
+	}
+	if !model.TlsRequired.ValueBool() || model.CreatedAt.IsNull() {
+		t.Errorf("tls_required or created_at were cleared")
+	}
+	for name, notNull := range map[string]bool{
+		"status":                model.Status.IsUnknown() || !model.Status.IsNull(),
+		"health":                model.Health.IsUnknown() || !model.Health.IsNull(),
+		"shards":                model.Shards.IsUnknown() || !model.Shards.IsNull(),
+		"errors":                model.Errors.IsUnknown() || !model.Errors.IsNull(),
+		"pending_modifications": model.PendingModifications.IsUnknown() || !model.PendingModifications.IsNull(),
+	} {
+		if notNull {
+			t.Errorf("%s is not null", name)
+		}
+	}
+}
+
+func testShardPlacement(index int64, availabilityZone string, replicaAvailabilityZones ...string) ShardPlacementModel {
+	replicas := make([]types.String, len(replicaAvailabilityZones))
+	for i, az := range replicaAvailabilityZones {
+		replicas[i] = types.StringValue(az)
+	}
+	return ShardPlacementModel{
+		Index:                    types.Int64Value(index),
+		AvailabilityZone:         types.StringValue(availabilityZone),
+		ReplicaAvailabilityZones: replicas,
+	}
+}
+
+func TestValidateValkeyClusterUpdateShardPlacements(t *testing.T) {
+	placements := []ShardPlacementModel{testShardPlacement(0, "usw2-az1", "usw2-az2"), testShardPlacement(1, "usw2-az2", "usw2-az1")}
+	movedReplica := []ShardPlacementModel{testShardPlacement(0, "usw2-az1", "usw2-az3"), testShardPlacement(1, "usw2-az2", "usw2-az1")}
+	reordered := []ShardPlacementModel{placements[1], placements[0]}
+	tests := []struct {
+		name               string
+		current            []ShardPlacementModel
+		planned            []ShardPlacementModel
+		wantReplace        bool
+		wantPlacementsDiff bool
+	}{
+		{name: "unmanaged", current: nil, planned: nil},
+		{name: "removed from configuration", current: placements, planned: nil},
+		{name: "added to configuration", current: nil, planned: placements},
+		{name: "unchanged", current: placements, planned: placements},
+		{name: "reordered", current: placements, planned: reordered},
+		{name: "changed", current: placements, planned: movedReplica, wantReplace: true, wantPlacementsDiff: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current := ValkeyClusterResourceModel{
+				ShardCount:        types.Int64Value(2),
+				ReplicationFactor: types.Int64Value(1),
+				ShardPlacements:   tt.current,
+				ApplyImmediately:  types.BoolValue(true),
+			}
+			plan := current
+			plan.ShardPlacements = tt.planned
+
+			if got := determineDiff(current, plan)["shard_placements"]; got != tt.wantPlacementsDiff {
+				t.Errorf("shard_placements diff = %v, want %v", got, tt.wantPlacementsDiff)
+			}
+			attrErr, requiresReplace := validateValkeyClusterUpdate(&current, &plan)
+			if requiresReplace != tt.wantReplace {
+				t.Errorf("requiresReplace = %v, want %v", requiresReplace, tt.wantReplace)
+			}
+			if (attrErr != nil) != tt.wantReplace {
+				t.Errorf("attrErr = %v, want an error %v", attrErr, tt.wantReplace)
+			}
+		})
+	}
+}
+
+// formatShardPlacements formats placements as "index:primary/replica,replica" in order.
+func formatShardPlacements(placements []ShardPlacementModel) []string {
+	formatted := make([]string, len(placements))
+	for i, sp := range placements {
+		replicas := make([]string, len(sp.ReplicaAvailabilityZones))
+		for j, az := range sp.ReplicaAvailabilityZones {
+			replicas[j] = az.ValueString()
+		}
+		formatted[i] = fmt.Sprintf("%d:%s/%s", sp.Index.ValueInt64(), sp.AvailabilityZone.ValueString(), strings.Join(replicas, ","))
+	}
+	return formatted
+}
+
+func TestBalanceShardPlacements(t *testing.T) {
+	tests := []struct {
+		name              string
+		existing          []ShardPlacementModel
+		availabilityZones []string
+		shardCount        int64
+		replicationFactor int64
+		multiAz           bool
+		want              []string
+	}{
+		{
+			name:              "new cluster spreads primaries and replicas",
+			availabilityZones: []string{"a", "b", "c"},
+			shardCount:        3,
+			replicationFactor: 2,
+			multiAz:           true,
+			want:              []string{"0:a/b,c", "1:b/a,c", "2:c/a,b"},
+		},
+		{
+			name:              "scale in removes the highest indexes and extra replicas",
+			existing:          []ShardPlacementModel{testShardPlacement(2, "c", "a", "b"), testShardPlacement(0, "a", "b", "c"), testShardPlacement(1, "b", "a", "c")},
+			availabilityZones: []string{"a", "b", "c"},
+			shardCount:        2,
+			replicationFactor: 1,
+			multiAz:           true,
+			want:              []string{"0:a/b", "1:b/a"},
+		},
+		{
+			name:              "scale out keeps existing shards",
+			existing:          []ShardPlacementModel{testShardPlacement(0, "a", "b")},
+			availabilityZones: []string{"a", "b"},
+			shardCount:        2,
+			replicationFactor: 1,
+			multiAz:           true,
+			want:              []string{"0:a/b", "1:b/a"},
+		},
+		{
+			name:              "new shards are indexed after the highest existing index",
+			existing:          []ShardPlacementModel{testShardPlacement(2, "a")},
+			availabilityZones: []string{"a", "b"},
+			shardCount:        2,
+			replicationFactor: 0,
+			want:              []string{"2:a/", "3:b/"},
+		},
+		{
+			name:              "single zone without multi-AZ",
+			availabilityZones: []string{"a"},
+			shardCount:        1,
+			replicationFactor: 1,
+			want:              []string{"0:a/a"},
+		},
+		{
+			name:              "single zone with multi-AZ falls back to the primary zone",
+			availabilityZones: []string{"a"},
+			shardCount:        1,
+			replicationFactor: 1,
+			multiAz:           true,
+			want:              []string{"0:a/a"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := formatShardPlacements(balanceShardPlacements(tt.existing, tt.availabilityZones, tt.shardCount, tt.replicationFactor, tt.multiAz))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("balanceShardPlacements() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBalanceShardPlacementsDoesNotModifyExisting(t *testing.T) {
+	existing := []ShardPlacementModel{testShardPlacement(1, "b", "a", "c"), testShardPlacement(0, "a", "b", "c")}
+	balanceShardPlacements(existing, []string{"a", "b", "c"}, 1, 1, true)
+	if got, want := formatShardPlacements(existing), []string{"1:b/a,c", "0:a/b,c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("existing placements = %v, want %v", got, want)
+	}
+}
+
+func TestAvailabilityZonesOfPlacements(t *testing.T) {
+	placements := []ShardPlacementModel{
+		testShardPlacement(0, "b", "c", "a"),
+		testShardPlacement(1, "a", "d"),
+		{Index: types.Int64Value(2), AvailabilityZone: types.StringNull(), ReplicaAvailabilityZones: []types.String{types.StringValue("b")}},
+	}
+	if got, want := availabilityZonesOfPlacements(placements), []string{"b", "a", "c", "d"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("availabilityZonesOfPlacements() = %v, want %v", got, want)
+	}
+	if got := availabilityZonesOfPlacements(nil); len(got) != 0 {
+		t.Errorf("availabilityZonesOfPlacements(nil) = %v, want none", got)
+	}
+}
+
+func TestShardIndexesToRemove(t *testing.T) {
+	current := []ShardPlacementModel{testShardPlacement(3, "a"), testShardPlacement(1, "b"), testShardPlacement(2, "c")}
+	tests := []struct {
+		name              string
+		current           []ShardPlacementModel
+		planned           []ShardPlacementModel
+		currentShardCount int64
+		shardCount        int64
+		want              []int
+	}{
+		{
+			name:              "shards missing from the planned placements",
+			current:           current,
+			planned:           []ShardPlacementModel{testShardPlacement(1, "b"), testShardPlacement(3, "a")},
+			currentShardCount: 3,
+			shardCount:        2,
+			want:              []int{2},
+		},
+		{
+			name:              "highest current indexes without planned placements",
+			current:           current,
+			currentShardCount: 3,
+			shardCount:        1,
+			want:              []int{2, 3},
+		},
+		{
+			name:              "indexes from 0 without placements",
+			currentShardCount: 4,
+			shardCount:        2,
+			want:              []int{2, 3},
+		},
+		{
+			name:              "nothing to remove",
+			currentShardCount: 2,
+			shardCount:        2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := shardIndexesToRemove(tt.current, tt.planned, tt.currentShardCount, tt.shardCount)
+			if len(got) != 0 || len(tt.want) != 0 {
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("shardIndexesToRemove() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateValkeyClusterSizeArguments(t *testing.T) {
+	set, unset := types.Int64Value(2), types.Int64Null()
+	tests := []struct {
+		name                 string
+		ignoreAutoscaledSize bool
+		shardCount           types.Int64
+		replicationFactor    types.Int64
+		initialShardCount    types.Int64
+		initialReplication   types.Int64
+		wantErrors           []string
+	}{
+		{name: "sizes", shardCount: set, replicationFactor: set, initialShardCount: unset, initialReplication: unset},
+		{name: "missing sizes", shardCount: unset, replicationFactor: unset, initialShardCount: unset, initialReplication: unset, wantErrors: []string{"shard_count", "replication_factor"}},
+		{name: "initial sizes without autoscaling", shardCount: set, replicationFactor: set, initialShardCount: set, initialReplication: unset, wantErrors: []string{"initial_shard_count"}},
+		{name: "initial sizes with autoscaling", ignoreAutoscaledSize: true, shardCount: unset, replicationFactor: unset, initialShardCount: set, initialReplication: set},
+		{name: "sizes with autoscaling", ignoreAutoscaledSize: true, shardCount: set, replicationFactor: set, initialShardCount: set, initialReplication: set, wantErrors: []string{"shard_count", "replication_factor"}},
+		{name: "missing initial sizes with autoscaling", ignoreAutoscaledSize: true, shardCount: unset, replicationFactor: unset, initialShardCount: unset, initialReplication: set, wantErrors: []string{"initial_shard_count"}},
+		{name: "unknown sizes", shardCount: types.Int64Unknown(), replicationFactor: types.Int64Unknown(), initialShardCount: unset, initialReplication: unset},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, attrErr := range validateValkeyClusterSizeArguments(tt.ignoreAutoscaledSize, tt.shardCount, tt.replicationFactor, tt.initialShardCount, tt.initialReplication) {
+				got = append(got, attrErr.AttributePath.String())
+			}
+			if !reflect.DeepEqual(got, tt.wantErrors) {
+				t.Errorf("errors on %v, want %v", got, tt.wantErrors)
+			}
+		})
+	}
+}
+
+// testValkeyClusterValue builds a value of the valkey cluster schema with the given attributes, the others are null.
+func testValkeyClusterValue(t *testing.T, schemaType tftypes.Object, attributes map[string]tftypes.Value) tftypes.Value {
+	t.Helper()
+	values := make(map[string]tftypes.Value, len(schemaType.AttributeTypes))
+	for name, attributeType := range schemaType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	for name, value := range attributes {
+		if _, ok := values[name]; !ok {
+			t.Fatalf("unknown attribute %q", name)
+		}
+		values[name] = value
+	}
+	return tftypes.NewValue(schemaType, values)
+}
+
+// testModifyValkeyClusterPlan runs ModifyPlan and checks that the planned values of the configured attributes are the
+// configured values, which Terraform requires of every plan.
+func testModifyValkeyClusterPlan(t *testing.T, config map[string]tftypes.Value, plan map[string]tftypes.Value, state map[string]tftypes.Value) *resource.ModifyPlanResponse {
+	t.Helper()
+	ctx := context.Background()
+	r := &ValkeyClusterResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	schemaType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	req := resource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: testValkeyClusterValue(t, schemaType, config)},
+		Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: testValkeyClusterValue(t, schemaType, plan)},
+		State:  tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaType, nil)},
+	}
+	if state != nil {
+		req.State.Raw = testValkeyClusterValue(t, schemaType, state)
+	}
+	resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+	r.ModifyPlan(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var planned map[string]tftypes.Value
+	if err := resp.Plan.Raw.As(&planned); err != nil {
+		t.Fatal(err)
+	}
+	for name, value := range config {
+		if !value.IsNull() && !planned[name].Equal(value) {
+			t.Errorf("planned %s = %v, want the configured value %v", name, planned[name], value)
+		}
+	}
+	return resp
+}
+
+// testValkeyClusterConfig returns the attributes of a cluster whose size is left to the autoscaler, with overrides.
+func testValkeyClusterConfig(overrides map[string]tftypes.Value) map[string]tftypes.Value {
+	config := map[string]tftypes.Value{
+		"cluster_name":               tftypes.NewValue(tftypes.String, "test"),
+		"node_instance_type":         tftypes.NewValue(tftypes.String, "cache.r7g.large"),
+		"enforce_shard_multi_az":     tftypes.NewValue(tftypes.Bool, true),
+		"availability_zones":         tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "usw2-az1"), tftypes.NewValue(tftypes.String, "usw2-az2")}),
+		"ignore_autoscaled_size":     tftypes.NewValue(tftypes.Bool, true),
+		"initial_shard_count":        tftypes.NewValue(tftypes.Number, 2),
+		"initial_replication_factor": tftypes.NewValue(tftypes.Number, 1),
+	}
+	for name, value := range overrides {
+		config[name] = value
+	}
+	return config
+}
+
+func TestValkeyClusterModifyPlanCreatesWithInitialSize(t *testing.T) {
+	config := testValkeyClusterConfig(nil)
+	plan := testValkeyClusterConfig(map[string]tftypes.Value{
+		"shard_count":        tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+		"replication_factor": tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+	})
+	resp := testModifyValkeyClusterPlan(t, config, plan, nil)
+
+	var shardCount, replicationFactor types.Int64
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(context.Background(), path.Root("shard_count"), &shardCount)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(context.Background(), path.Root("replication_factor"), &replicationFactor)...)
+	if shardCount.ValueInt64() != 2 || replicationFactor.ValueInt64() != 1 {
+		t.Errorf("planned size = %v shards with replication factor %v, want the initial size", shardCount, replicationFactor)
+	}
+	var effective ShardPlacementsValue
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(context.Background(), path.Root("effective_shard_placements"), &effective)...)
+	if len(effective.Elements()) != 2 {
+		t.Errorf("planned %d effective shard placements, want 2", len(effective.Elements()))
+	}
+}
+
+func TestValkeyClusterModifyPlanKeepsAutoscaledSize(t *testing.T) {
+	// The autoscaler grew the cluster to 5 shards with 2 replicas each, which the last refresh saved
+	state := testValkeyClusterConfig(map[string]tftypes.Value{
+		"id":                 tftypes.NewValue(tftypes.String, "test"),
+		"shard_count":        tftypes.NewValue(tftypes.Number, 5),
+		"replication_factor": tftypes.NewValue(tftypes.Number, 2),
+		"apply_immediately":  tftypes.NewValue(tftypes.Bool, true),
+	})
+	config := testValkeyClusterConfig(nil)
+	// The sizes are not configured, so they are planned from state
+	plan := testValkeyClusterConfig(map[string]tftypes.Value{
+		"id":                 tftypes.NewValue(tftypes.String, "test"),
+		"shard_count":        tftypes.NewValue(tftypes.Number, 5),
+		"replication_factor": tftypes.NewValue(tftypes.Number, 2),
+		"apply_immediately":  tftypes.NewValue(tftypes.Bool, true),
+	})
+	resp := testModifyValkeyClusterPlan(t, config, plan, state)
+
+	if len(resp.RequiresReplace) != 0 {
+		t.Errorf("RequiresReplace = %v, want none", resp.RequiresReplace)
+	}
+	var shardCount, replicationFactor types.Int64
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(context.Background(), path.Root("shard_count"), &shardCount)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(context.Background(), path.Root("replication_factor"), &replicationFactor)...)
+	if shardCount.ValueInt64() != 5 || replicationFactor.ValueInt64() != 2 {
+		t.Errorf("planned size = %v shards with replication factor %v, want the autoscaled size", shardCount, replicationFactor)
+	}
+}
@@ -0,0 +1,409 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &ValkeyClusterSnapshotResource{}
+	_ resource.ResourceWithConfigure      = &ValkeyClusterSnapshotResource{}
+	_ resource.ResourceWithImportState    = &ValkeyClusterSnapshotResource{}
+	_ resource.ResourceWithValidateConfig = &ValkeyClusterSnapshotResource{}
+)
+
+func NewValkeyClusterSnapshotResource() resource.Resource {
+	return &ValkeyClusterSnapshotResource{}
+}
+
+// ValkeyClusterSnapshotResource defines the resource implementation.
+type ValkeyClusterSnapshotResource struct {
+	httpClient    *http.Client
+	httpEndpoint  string
+	httpAuthToken string
+}
+
+// ValkeyClusterSnapshotResourceModel describes the resource data model.
+type ValkeyClusterSnapshotResourceModel struct {
+	Id           types.String   `tfsdk:"id"`
+	ClusterName  types.String   `tfsdk:"cluster_name"`
+	SnapshotName types.String   `tfsdk:"snapshot_name"`
+	PollInterval types.String   `tfsdk:"poll_interval"`
+	Status       types.String   `tfsdk:"status"`
+	SizeBytes    types.Int64    `tfsdk:"size_bytes"`
+	CreatedAt    types.String   `tfsdk:"created_at"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *ValkeyClusterSnapshotResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_valkey_cluster_snapshot"
+}
+
+func (r *ValkeyClusterSnapshotResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A point-in-time snapshot of a Valkey Cluster. A new cluster can be seeded from the snapshot with the `snapshot_name` attribute of `momento_valkey_cluster`.",
+
+		Attributes: map[string]schema.Attribute{
+			// The testing framework requires an id attribute to be present in every data source and resource
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the snapshot, which is the name of the snapshot.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the Valkey Cluster to snapshot. Changing the cluster destroys the snapshot and takes a new one.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"snapshot_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the snapshot. Changing the name destroys the snapshot and takes a new one.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"poll_interval": schema.StringAttribute{
+				MarkdownDescription: "How long to wait between checks of the snapshot status while it is created or deleted, as a duration such as `15s`. The interval backs off while the status does not change, up to 2 minutes or the configured interval if longer. Defaults to `30s`.",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the snapshot, e.g. `Available`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"size_bytes": schema.Int64Attribute{
+				MarkdownDescription: "The size of the snapshot in bytes.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The time the snapshot was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *ValkeyClusterSnapshotResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(MomentoClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected MomentoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.httpClient = clients.httpClient
+	r.httpEndpoint = clients.httpEndpoint
+	r.httpAuthToken = clients.httpAuthToken
+}
+
+func (r *ValkeyClusterSnapshotResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var pollInterval types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("poll_interval"), &pollInterval)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if attrErr := validatePollInterval(pollInterval); attrErr != nil {
+		resp.Diagnostics.AddAttributeError(attrErr.AttributePath, attrErr.Summary, attrErr.Detail)
+	}
+}
+
+func (r *ValkeyClusterSnapshotResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ValkeyClusterSnapshotResourceModel
+
+	// Retrieve values from the plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, 120*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	snapshotName := plan.SnapshotName.ValueString()
+	err := createValkeyClusterSnapshot(*r.httpClient, plan.ClusterName.ValueString(), snapshotName, r.httpEndpoint, r.httpAuthToken)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create valkey cluster snapshot, got error: %s", err))
+		return
+	}
+
+	// Save the snapshot before waiting so that it is deleted on destroy even if waiting fails
+	plan.Id = types.StringValue(snapshotName)
+	plan.Status = types.StringNull()
+	plan.SizeBytes = types.Int64Null()
+	plan.CreatedAt = types.StringNull()
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	foundSnapshot, err := waitUntilValkeyClusterSnapshotAvailable(ctx, *r.httpClient, snapshotName, r.httpEndpoint, r.httpAuthToken, pollIntervalFromConfig(plan.PollInterval))
+	if err != nil {
+		resp.Diagnostics.AddError("Snapshot Creation Failed", fmt.Sprintf("Snapshot \"%s\" of cluster \"%s\" did not become available: %s", snapshotName, plan.ClusterName.ValueString(), err))
+		return
+	}
+
+	setValkeyClusterSnapshotAttributes(&plan, foundSnapshot)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ValkeyClusterSnapshotResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ValkeyClusterSnapshotResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	foundSnapshot, err := describeValkeyClusterSnapshot(*r.httpClient, state.Id.ValueString(), r.httpEndpoint, r.httpAuthToken)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to describe valkey cluster snapshot, got error: %s", err))
+		return
+	}
+	if foundSnapshot == nil {
+		resp.Diagnostics.AddWarning("Snapshot Not Found", fmt.Sprintf("Snapshot with name \"%s\" not found, removing from state", state.Id.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.SnapshotName = types.StringValue(foundSnapshot.Name)
+	state.ClusterName = types.StringValue(foundSnapshot.ClusterName)
+	setValkeyClusterSnapshotAttributes(&state, foundSnapshot)
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ValkeyClusterSnapshotResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ValkeyClusterSnapshotResourceModel
+
+	// Only poll_interval and timeouts can change without replacement, and they only affect the next wait
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ValkeyClusterSnapshotResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ValkeyClusterSnapshotResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, 120*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	snapshotName := state.Id.ValueString()
+	client := *r.httpClient
+	deleteRequest, err := http.NewRequest("DELETE", fmt.Sprintf("%s/ec-cluster-snapshot/%s", r.httpEndpoint, snapshotName), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create HTTP request to delete valkey cluster snapshot, got error: %s", err))
+		return
+	}
+	deleteRequest.Header.Set("Authorization", r.httpAuthToken)
+	httpResp, err := client.Do(deleteRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete valkey cluster snapshot, got error: %s", err))
+		return
+	}
+	defer func() { _ = httpResp.Body.Close() }()
+	if httpResp.StatusCode == 404 {
+		// If the snapshot is already gone, no need to poll
+		return
+	}
+	if httpResp.StatusCode >= 300 {
+		body, _ := io.ReadAll(httpResp.Body)
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete valkey cluster snapshot, got non-200 response: %s %s", httpResp.Status, string(body)))
+		return
+	}
+
+	// Poll until the snapshot is confirmed deleted (404)
+	w := waiter{Description: fmt.Sprintf("valkey cluster snapshot %q to be deleted", snapshotName), PollInterval: pollIntervalFromConfig(state.PollInterval)}
+	_, err = w.Wait(ctx, func(ctx context.Context) (string, bool, error) {
+		foundSnapshot, err := describeValkeyClusterSnapshot(client, snapshotName, r.httpEndpoint, r.httpAuthToken)
+		if err != nil {
+			return "", false, err
+		}
+		if foundSnapshot == nil {
+			return "Deleted", true, nil
+		}
+		return foundSnapshot.Status, false, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Snapshot Deletion Failed", fmt.Sprintf("Snapshot \"%s\" deletion failed with error: %s. You may need to manually delete the snapshot.", snapshotName, err))
+	}
+}
+
+func (r *ValkeyClusterSnapshotResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func setValkeyClusterSnapshotAttributes(model *ValkeyClusterSnapshotResourceModel, snapshot *DescribeValkeyClusterSnapshotResponseData) {
+	model.Id = types.StringValue(snapshot.Name)
+	model.Status = types.StringValue(snapshot.Status)
+	model.SizeBytes = types.Int64Value(snapshot.SizeBytes)
+	model.CreatedAt = types.StringValue(snapshot.CreatedAt)
+}
+
+type DescribeValkeyClusterSnapshotResponseData struct {
+	Name        string   `json:"name"`
+	ClusterName string   `json:"cluster_name"`
+	Status      string   `json:"status"`
+	SizeBytes   int64    `json:"size_bytes"`
+	CreatedAt   string   `json:"created_at"`
+	Errors      []string `json:"errors"`
+}
+
+// POST /ec-cluster/<cluster-name>/snapshot
+// Required fields: snapshot_name
+// Expected response: 202 Accepted.
+func createValkeyClusterSnapshot(client http.Client, clusterName string, snapshotName string, httpEndpoint string, httpAuthToken string) error {
+	requestJson, err := json.Marshal(map[string]interface{}{
+		"snapshot_name": snapshotName,
+	})
+	if err != nil {
+		return err
+	}
+
+	postRequest, err := http.NewRequest("POST", fmt.Sprintf("%s/ec-cluster/%s/snapshot", httpEndpoint, clusterName), bytes.NewBuffer(requestJson))
+	if err != nil {
+		return err
+	}
+	postRequest.Header.Set("Authorization", httpAuthToken)
+	postRequest.Header.Set("Content-Type", "application/json")
+
+	httpResp, err := client.Do(postRequest)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = httpResp.Body.Close() }()
+	if httpResp.StatusCode != 202 {
+		respBody, _ := io.ReadAll(httpResp.Body)
+		return fmt.Errorf("unable to create snapshot, got non-202 response: %s %s", httpResp.Status, string(respBody))
+	}
+	return nil
+}
+
+// GET /ec-cluster-snapshot/<snapshot-name>
+// Returns nil without an error when the snapshot does not exist.
+func describeValkeyClusterSnapshot(client http.Client, snapshotName string, httpEndpoint string, httpAuthToken string) (*DescribeValkeyClusterSnapshotResponseData, error) {
+	getRequest, err := http.NewRequest("GET", fmt.Sprintf("%s/ec-cluster-snapshot/%s", httpEndpoint, snapshotName), nil)
+	if err != nil {
+		return nil, err
+	}
+	getRequest.Header.Set("Authorization", httpAuthToken)
+	getResp, err := client.Do(getRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = getResp.Body.Close() }()
+	// Do not error if 404 not found
+	if getResp.StatusCode == 404 {
+		return nil, nil
+	}
+	if getResp.StatusCode >= 300 {
+		body, _ := io.ReadAll(getResp.Body)
+		return nil, fmt.Errorf("unable to describe valkey cluster snapshot, got non-200 response: %s %s", getResp.Status, string(body))
+	}
+
+	bodyBytes, err := io.ReadAll(getResp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %v", err)
+	}
+
+	var snapshot DescribeValkeyClusterSnapshotResponseData
+	err = json.Unmarshal(bodyBytes, &snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling JSON: %v", err)
+	}
+	return &snapshot, nil
+}
+
+// waitUntilValkeyClusterSnapshotAvailable polls until the snapshot status is "Available", and fails if it becomes
+// "Failed" instead.
+func waitUntilValkeyClusterSnapshotAvailable(ctx context.Context, client http.Client, snapshotName string, httpEndpoint string, httpAuthToken string, pollInterval time.Duration) (*DescribeValkeyClusterSnapshotResponseData, error) {
+	var foundSnapshot *DescribeValkeyClusterSnapshotResponseData
+	w := waiter{Description: fmt.Sprintf("valkey cluster snapshot %q to become available", snapshotName), PollInterval: pollInterval}
+	status, err := w.Wait(ctx, func(ctx context.Context) (string, bool, error) {
+		var err error
+		foundSnapshot, err = describeValkeyClusterSnapshot(client, snapshotName, httpEndpoint, httpAuthToken)
+		if err != nil {
+			return "", false, err
+		}
+		if foundSnapshot == nil {
+			// The snapshot may not be registered yet right after it was requested
+			return "NotFound", false, nil
+		}
+		return foundSnapshot.Status, foundSnapshot.Status == "Available" || foundSnapshot.Status == "Failed", nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if status != "Available" {
+		return nil, fmt.Errorf("snapshot reached status %s with errors: %v", status, foundSnapshot.Errors)
+	}
+	return foundSnapshot, nil
+}
@@ -0,0 +1,254 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &ValkeyClusterWaiterResource{}
+	_ resource.ResourceWithConfigure      = &ValkeyClusterWaiterResource{}
+	_ resource.ResourceWithValidateConfig = &ValkeyClusterWaiterResource{}
+)
+
+func NewValkeyClusterWaiterResource() resource.Resource {
+	return &ValkeyClusterWaiterResource{}
+}
+
+// ValkeyClusterWaiterResource defines the resource implementation.
+type ValkeyClusterWaiterResource struct {
+	httpClient    *http.Client
+	httpEndpoint  string
+	httpAuthToken string
+}
+
+// ValkeyClusterWaiterResourceModel describes the resource data model.
+type ValkeyClusterWaiterResourceModel struct {
+	Id                    types.String   `tfsdk:"id"`
+	ClusterName           types.String   `tfsdk:"cluster_name"`
+	TargetStatus          types.String   `tfsdk:"target_status"`
+	PollInterval          types.String   `tfsdk:"poll_interval"`
+	Status                types.String   `tfsdk:"status"`
+	ConfigurationEndpoint types.String   `tfsdk:"configuration_endpoint"`
+	Port                  types.Int64    `tfsdk:"port"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *ValkeyClusterWaiterResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_valkey_cluster_waiter"
+}
+
+func (r *ValkeyClusterWaiterResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Waits for a Valkey Cluster to reach a target status when it is created. Use it with `wait_for_ready = false` on `momento_valkey_cluster` so that only the resources depending on this waiter wait for the cluster, while the rest of the configuration is applied in parallel. Destroying the waiter does not affect the cluster.",
+
+		Attributes: map[string]schema.Attribute{
+			// The testing framework requires an id attribute to be present in every data source and resource
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the waiter, which is the name of the Valkey Cluster.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the Valkey Cluster to wait for.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_status": schema.StringAttribute{
+				MarkdownDescription: "The status to wait for. Defaults to `Active`. Waiting fails if the cluster reaches `CreationFailed` instead.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("Active"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"poll_interval": schema.StringAttribute{
+				MarkdownDescription: "How long to wait between checks of the cluster status, as a duration such as `15s`. The interval backs off while the status does not change, up to 2 minutes or the configured interval if longer. Defaults to `30s`.",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the Valkey Cluster when the wait finished.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"configuration_endpoint": schema.StringAttribute{
+				MarkdownDescription: "The cluster configuration (discovery) endpoint hostname that cluster-mode clients should connect to.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "The port of the configuration endpoint.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *ValkeyClusterWaiterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(MomentoClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected MomentoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.httpClient = clients.httpClient
+	r.httpEndpoint = clients.httpEndpoint
+	r.httpAuthToken = clients.httpAuthToken
+}
+
+func (r *ValkeyClusterWaiterResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var pollInterval types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("poll_interval"), &pollInterval)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if attrErr := validatePollInterval(pollInterval); attrErr != nil {
+		resp.Diagnostics.AddAttributeError(attrErr.AttributePath, attrErr.Summary, attrErr.Detail)
+	}
+}
+
+func (r *ValkeyClusterWaiterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ValkeyClusterWaiterResourceModel
+
+	// Retrieve values from the plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, 120*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	clusterName := plan.ClusterName.ValueString()
+	targetStatus := plan.TargetStatus.ValueString()
+
+	var foundCluster *DescribeValkeyClustersResponseData
+	w := waiter{Description: fmt.Sprintf("valkey cluster %q to reach status %q", clusterName, targetStatus), PollInterval: pollIntervalFromConfig(plan.PollInterval)}
+	status, err := w.Wait(ctx, func(ctx context.Context) (string, bool, error) {
+		var err error
+		foundCluster, err = describeValkeyCluster(*r.httpClient, clusterName, r.httpEndpoint, r.httpAuthToken)
+		if err != nil {
+			return "", false, err
+		}
+		if foundCluster == nil {
+			// The cluster may not be registered yet right after it was requested
+			return "NotFound", false, nil
+		}
+		return foundCluster.Status, foundCluster.Status == targetStatus || foundCluster.Status == "CreationFailed", nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Cluster Not Ready", fmt.Sprintf("Error waiting for cluster \"%s\": %s", clusterName, err))
+		return
+	}
+	if status != targetStatus {
+		resp.Diagnostics.AddError("Cluster Not Ready", fmt.Sprintf("Cluster \"%s\" reached status %s while waiting for status %s, with errors: %v", clusterName, status, targetStatus, foundCluster.Errors))
+		return
+	}
+
+	plan.Id = types.StringValue(clusterName)
+	setValkeyClusterWaiterAttributes(&plan, foundCluster)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ValkeyClusterWaiterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ValkeyClusterWaiterResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The wait is only repeated if the cluster is replaced, so a waiter for a cluster that is gone is removed
+	foundCluster, err := describeValkeyCluster(*r.httpClient, state.ClusterName.ValueString(), r.httpEndpoint, r.httpAuthToken)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to describe valkey cluster, got error: %s", err))
+		return
+	}
+	if foundCluster == nil {
+		resp.Diagnostics.AddWarning("Cluster Not Found", fmt.Sprintf("Cluster with name \"%s\" not found, removing waiter from state", state.ClusterName.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	setValkeyClusterWaiterAttributes(&state, foundCluster)
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ValkeyClusterWaiterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ValkeyClusterWaiterResourceModel
+
+	// Only poll_interval and timeouts can change without replacement, and they only affect the next wait
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ValkeyClusterWaiterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Destroying the waiter does not affect the cluster
+}
+
+func setValkeyClusterWaiterAttributes(model *ValkeyClusterWaiterResourceModel, cluster *DescribeValkeyClustersResponseData) {
+	model.Status = types.StringValue(cluster.Status)
+	model.ConfigurationEndpoint = types.StringNull()
+	model.Port = types.Int64Null()
+	if cluster.ConfigurationEndpoint != nil {
+		model.ConfigurationEndpoint = types.StringValue(cluster.ConfigurationEndpoint.Address)
+		model.Port = types.Int64Value(cluster.ConfigurationEndpoint.Port)
+	}
+}
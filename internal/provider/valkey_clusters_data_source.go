@@ -0,0 +1,223 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &ValkeyClustersDataSource{}
+	_ datasource.DataSourceWithConfigure = &ValkeyClustersDataSource{}
+)
+
+func NewValkeyClustersDataSource() datasource.DataSource {
+	return &ValkeyClustersDataSource{}
+}
+
+// ValkeyClustersDataSource defines the data source implementation.
+type ValkeyClustersDataSource struct {
+	httpClient    *http.Client
+	httpEndpoint  string
+	httpAuthToken string
+}
+
+// ValkeyClustersDataSourceModel describes the data source data model.
+type ValkeyClustersDataSourceModel struct {
+	Id         types.String                                 `tfsdk:"id"`
+	NamePrefix types.String                                 `tfsdk:"name_prefix"`
+	NameRegex  types.String                                 `tfsdk:"name_regex"`
+	Status     types.String                                 `tfsdk:"status"`
+	Clusters   []ValkeyClustersDataSourceValkeyClusterModel `tfsdk:"clusters"`
+}
+
+type ValkeyClustersDataSourceValkeyClusterModel struct {
+	Name              types.String   `tfsdk:"name"`
+	Status            types.String   `tfsdk:"status"`
+	NodeInstanceType  types.String   `tfsdk:"node_instance_type"`
+	ShardCount        types.Int64    `tfsdk:"shard_count"`
+	ReplicationFactor types.Int64    `tfsdk:"replication_factor"`
+	Errors            []types.String `tfsdk:"errors"`
+}
+
+func (d *ValkeyClustersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_valkey_clusters"
+}
+
+func (d *ValkeyClustersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A list of Valkey Clusters. Use `momento_valkey_cluster` for the placements and connection details of a single cluster.",
+
+		Attributes: map[string]schema.Attribute{
+			// The testing framework requires an id attribute to be present in every data source and resource
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+			"name_prefix": schema.StringAttribute{
+				MarkdownDescription: "Only return clusters whose name starts with this prefix.",
+				Optional:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only return clusters whose name matches this regular expression.",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only return clusters with this status, e.g. `Active`.",
+				Optional:            true,
+			},
+			"clusters": schema.ListNestedAttribute{
+				Description: "List of clusters.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the cluster.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Status of the cluster.",
+							Computed:    true,
+						},
+						"node_instance_type": schema.StringAttribute{
+							Description: "The instance type of the nodes of the cluster.",
+							Computed:    true,
+						},
+						"shard_count": schema.Int64Attribute{
+							Description: "The number of shards.",
+							Computed:    true,
+						},
+						"replication_factor": schema.Int64Attribute{
+							Description: "The number of replicas per shard.",
+							Computed:    true,
+						},
+						"errors": schema.ListAttribute{
+							Description: "The errors last reported for the cluster.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ValkeyClustersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(MomentoClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected MomentoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.httpClient = clients.httpClient
+	d.httpEndpoint = clients.httpEndpoint
+	d.httpAuthToken = clients.httpAuthToken
+}
+
+func (d *ValkeyClustersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ValkeyClustersDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Retrieve data from the API
+	clusters, err := listValkeyClusters(*d.httpClient, d.httpEndpoint, d.httpAuthToken)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to list valkey clusters, got error: %s", err.Error()),
+		)
+		return
+	}
+
+	clustersByName := make(map[string]DescribeValkeyClustersResponseData, len(clusters))
+	names := make([]string, 0, len(clusters))
+	for _, cluster := range clusters {
+		clustersByName[cluster.Name] = cluster
+		names = append(names, cluster.Name)
+	}
+	names, err = filterNames(names, data.NamePrefix, data.NameRegex)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid value", fmt.Sprintf("Unable to compile name_regex: %s", err))
+		return
+	}
+
+	// Save data into the model
+	for _, name := range names {
+		cluster := clustersByName[name]
+		if !data.Status.IsNull() && cluster.Status != data.Status.ValueString() {
+			continue
+		}
+		clusterErrors := make([]types.String, 0, len(cluster.Errors))
+		for _, clusterError := range cluster.Errors {
+			clusterErrors = append(clusterErrors, types.StringValue(clusterError))
+		}
+		data.Clusters = append(data.Clusters, ValkeyClustersDataSourceValkeyClusterModel{
+			Name:              types.StringValue(cluster.Name),
+			Status:            types.StringValue(cluster.Status),
+			NodeInstanceType:  types.StringValue(cluster.NodeInstanceType),
+			ShardCount:        types.Int64Value(cluster.ShardCount),
+			ReplicationFactor: types.Int64Value(cluster.ReplicationFactor),
+			Errors:            clusterErrors,
+		})
+	}
+
+	data.Id = types.StringValue("placeholder")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// GET /ec-cluster
+// Expected response: a JSON array of clusters in the format of the describe response.
+func listValkeyClusters(client http.Client, httpEndpoint string, httpAuthToken string) ([]DescribeValkeyClustersResponseData, error) {
+	getRequest, err := http.NewRequest("GET", fmt.Sprintf("%s/ec-cluster", httpEndpoint), nil)
+	if err != nil {
+		return nil, err
+	}
+	getRequest.Header.Set("Authorization", httpAuthToken)
+	getResp, err := client.Do(getRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = getResp.Body.Close() }()
+	if getResp.StatusCode >= 300 {
+		body, _ := io.ReadAll(getResp.Body)
+		return nil, fmt.Errorf("unable to list valkey clusters, got non-200 response: %s %s", getResp.Status, string(body))
+	}
+
+	bodyBytes, err := io.ReadAll(getResp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %v", err)
+	}
+
+	var clusters []DescribeValkeyClustersResponseData
+	err = json.Unmarshal(bodyBytes, &clusters)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling JSON: %v", err)
+	}
+	return clusters, nil
+}
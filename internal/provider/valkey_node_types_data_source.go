@@ -0,0 +1,239 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource = &ValkeyNodeTypesDataSource{}
+)
+
+// valkeyNodeType is a node instance type supported by Valkey Clusters.
+type valkeyNodeType struct {
+	Name         string
+	Family       string
+	Vcpus        int64
+	MemoryGib    float64
+	NetworkClass string
+}
+
+// valkeyNodeTypes is the catalog of supported node instance types. It ships with the provider, so that node types can
+// be validated during plan without calling the API; supporting a new node type requires a provider release.
+var valkeyNodeTypes = []valkeyNodeType{
+	{Name: "cache.t3.micro", Family: "t3", Vcpus: 2, MemoryGib: 0.5, NetworkClass: "Up to 5 Gigabit"},
+	{Name: "cache.t3.small", Family: "t3", Vcpus: 2, MemoryGib: 1.37, NetworkClass: "Up to 5 Gigabit"},
+	{Name: "cache.t3.medium", Family: "t3", Vcpus: 2, MemoryGib: 3.09, NetworkClass: "Up to 5 Gigabit"},
+	{Name: "cache.t4g.micro", Family: "t4g", Vcpus: 2, MemoryGib: 0.5, NetworkClass: "Up to 5 Gigabit"},
+	{Name: "cache.t4g.small", Family: "t4g", Vcpus: 2, MemoryGib: 1.37, NetworkClass: "Up to 5 Gigabit"},
+	{Name: "cache.t4g.medium", Family: "t4g", Vcpus: 2, MemoryGib: 3.09, NetworkClass: "Up to 5 Gigabit"},
+	{Name: "cache.m6g.large", Family: "m6g", Vcpus: 2, MemoryGib: 6.38, NetworkClass: "Up to 10 Gigabit"},
+	{Name: "cache.m6g.xlarge", Family: "m6g", Vcpus: 4, MemoryGib: 12.93, NetworkClass: "Up to 10 Gigabit"},
+	{Name: "cache.m6g.2xlarge", Family: "m6g", Vcpus: 8, MemoryGib: 26.04, NetworkClass: "Up to 10 Gigabit"},
+	{Name: "cache.m6g.4xlarge", Family: "m6g", Vcpus: 16, MemoryGib: 52.26, NetworkClass: "Up to 10 Gigabit"},
+	{Name: "cache.m6g.8xlarge", Family: "m6g", Vcpus: 32, MemoryGib: 103.68, NetworkClass: "12 Gigabit"},
+	{Name: "cache.m6g.12xlarge", Family: "m6g", Vcpus: 48, MemoryGib: 157.12, NetworkClass: "20 Gigabit"},
+	{Name: "cache.m6g.16xlarge", Family: "m6g", Vcpus: 64, MemoryGib: 209.55, NetworkClass: "25 Gigabit"},
+	{Name: "cache.m7g.large", Family: "m7g", Vcpus: 2, MemoryGib: 6.38, NetworkClass: "Up to 12.5 Gigabit"},
+	{Name: "cache.m7g.xlarge", Family: "m7g", Vcpus: 4, MemoryGib: 12.93, NetworkClass: "Up to 12.5 Gigabit"},
+	{Name: "cache.m7g.2xlarge", Family: "m7g", Vcpus: 8, MemoryGib: 26.04, NetworkClass: "Up to 15 Gigabit"},
+	{Name: "cache.m7g.4xlarge", Family: "m7g", Vcpus: 16, MemoryGib: 52.26, NetworkClass: "Up to 15 Gigabit"},
+	{Name: "cache.m7g.8xlarge", Family: "m7g", Vcpus: 32, MemoryGib: 103.68, NetworkClass: "15 Gigabit"},
+	{Name: "cache.m7g.12xlarge", Family: "m7g", Vcpus: 48, MemoryGib: 157.12, NetworkClass: "22.5 Gigabit"},
+	{Name: "cache.m7g.16xlarge", Family: "m7g", Vcpus: 64, MemoryGib: 209.55, NetworkClass: "30 Gigabit"},
+	{Name: "cache.r6g.large", Family: "r6g", Vcpus: 2, MemoryGib: 13.07, NetworkClass: "Up to 10 Gigabit"},
+	{Name: "cache.r6g.xlarge", Family: "r6g", Vcpus: 4, MemoryGib: 26.32, NetworkClass: "Up to 10 Gigabit"},
+	{Name: "cache.r6g.2xlarge", Family: "r6g", Vcpus: 8, MemoryGib: 52.82, NetworkClass: "Up to 10 Gigabit"},
+	{Name: "cache.r6g.4xlarge", Family: "r6g", Vcpus: 16, MemoryGib: 105.81, NetworkClass: "Up to 10 Gigabit"},
+	{Name: "cache.r6g.8xlarge", Family: "r6g", Vcpus: 32, MemoryGib: 209.55, NetworkClass: "12 Gigabit"},
+	{Name: "cache.r6g.12xlarge", Family: "r6g", Vcpus: 48, MemoryGib: 317.77, NetworkClass: "20 Gigabit"},
+	{Name: "cache.r6g.16xlarge", Family: "r6g", Vcpus: 64, MemoryGib: 419.09, NetworkClass: "25 Gigabit"},
+	{Name: "cache.r7g.large", Family: "r7g", Vcpus: 2, MemoryGib: 13.07, NetworkClass: "Up to 12.5 Gigabit"},
+	{Name: "cache.r7g.xlarge", Family: "r7g", Vcpus: 4, MemoryGib: 26.32, NetworkClass: "Up to 12.5 Gigabit"},
+	{Name: "cache.r7g.2xlarge", Family: "r7g", Vcpus: 8, MemoryGib: 52.82, NetworkClass: "Up to 15 Gigabit"},
+	{Name: "cache.r7g.4xlarge", Family: "r7g", Vcpus: 16, MemoryGib: 105.81, NetworkClass: "Up to 15 Gigabit"},
+	{Name: "cache.r7g.8xlarge", Family: "r7g", Vcpus: 32, MemoryGib: 209.55, NetworkClass: "15 Gigabit"},
+	{Name: "cache.r7g.12xlarge", Family: "r7g", Vcpus: 48, MemoryGib: 317.77, NetworkClass: "22.5 Gigabit"},
+	{Name: "cache.r7g.16xlarge", Family: "r7g", Vcpus: 64, MemoryGib: 419.09, NetworkClass: "30 Gigabit"},
+}
+
+// findValkeyNodeType returns the catalog entry of a node instance type, or nil if it is not supported.
+func findValkeyNodeType(name string) *valkeyNodeType {
+	for i := range valkeyNodeTypes {
+		if valkeyNodeTypes[i].Name == name {
+			return &valkeyNodeTypes[i]
+		}
+	}
+	return nil
+}
+
+// validateValkeyNodeInstanceType checks that a node instance type is in the catalog, suggesting the closest supported
+// types when it is not. Unknown values are not checked.
+func validateValkeyNodeInstanceType(attributePath path.Path, nodeInstanceType types.String) *AttributeError {
+	if nodeInstanceType.IsNull() || nodeInstanceType.IsUnknown() || findValkeyNodeType(nodeInstanceType.ValueString()) != nil {
+		return nil
+	}
+	return &AttributeError{
+		AttributePath: attributePath,
+		Summary:       "Invalid value",
+		Detail: fmt.Sprintf("Node instance type %q is not supported. Did you mean %s? Use the momento_valkey_node_types data source to list the supported node types.",
+			nodeInstanceType.ValueString(), strings.Join(closestValkeyNodeTypes(nodeInstanceType.ValueString(), 3), ", ")),
+	}
+}
+
+// closestValkeyNodeTypes returns up to count supported node types with the smallest edit distance to name. A name
+// without the "cache." prefix is compared as if it had it.
+func closestValkeyNodeTypes(name string, count int) []string {
+	if !strings.HasPrefix(name, "cache.") {
+		name = "cache." + name
+	}
+	names := make([]string, len(valkeyNodeTypes))
+	distances := make(map[string]int, len(valkeyNodeTypes))
+	for i, nodeType := range valkeyNodeTypes {
+		names[i] = nodeType.Name
+		distances[nodeType.Name] = editDistance(strings.ToLower(name), nodeType.Name)
+	}
+	slices.SortStableFunc(names, func(a string, b string) int {
+		return distances[a] - distances[b]
+	})
+	return names[:min(count, len(names))]
+}
+
+// editDistance returns the Levenshtein distance between two strings.
+func editDistance(a string, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+func NewValkeyNodeTypesDataSource() datasource.DataSource {
+	return &ValkeyNodeTypesDataSource{}
+}
+
+// ValkeyNodeTypesDataSource defines the data source implementation.
+type ValkeyNodeTypesDataSource struct{}
+
+// ValkeyNodeTypesDataSourceModel describes the data source data model.
+type ValkeyNodeTypesDataSourceModel struct {
+	Id           types.String                   `tfsdk:"id"`
+	Family       types.String                   `tfsdk:"family"`
+	MinMemoryGib types.Float64                  `tfsdk:"min_memory_gib"`
+	NodeTypes    []ValkeyNodeTypesNodeTypeModel `tfsdk:"node_types"`
+}
+
+type ValkeyNodeTypesNodeTypeModel struct {
+	Name         types.String  `tfsdk:"name"`
+	Family       types.String  `tfsdk:"family"`
+	Vcpus        types.Int64   `tfsdk:"vcpus"`
+	MemoryGib    types.Float64 `tfsdk:"memory_gib"`
+	NetworkClass types.String  `tfsdk:"network_class"`
+}
+
+func (d *ValkeyNodeTypesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_valkey_node_types"
+}
+
+func (d *ValkeyNodeTypesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The node instance types supported by `momento_valkey_cluster`, ordered by family and size. The catalog ships with the provider and does not call the Momento API.",
+
+		Attributes: map[string]schema.Attribute{
+			// The testing framework requires an id attribute to be present in every data source and resource
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+			"family": schema.StringAttribute{
+				MarkdownDescription: "Only return node types of this family, e.g. `r7g`.",
+				Optional:            true,
+			},
+			"min_memory_gib": schema.Float64Attribute{
+				MarkdownDescription: "Only return node types with at least this much memory, in GiB.",
+				Optional:            true,
+			},
+			"node_types": schema.ListNestedAttribute{
+				Description: "List of node types.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the node type, for use as `node_instance_type`.",
+							Computed:            true,
+						},
+						"family": schema.StringAttribute{
+							MarkdownDescription: "The family of the node type.",
+							Computed:            true,
+						},
+						"vcpus": schema.Int64Attribute{
+							MarkdownDescription: "The number of vCPUs.",
+							Computed:            true,
+						},
+						"memory_gib": schema.Float64Attribute{
+							MarkdownDescription: "The memory available to Valkey, in GiB.",
+							Computed:            true,
+						},
+						"network_class": schema.StringAttribute{
+							MarkdownDescription: "The network performance class, e.g. `Up to 10 Gigabit`.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ValkeyNodeTypesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ValkeyNodeTypesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.NodeTypes = []ValkeyNodeTypesNodeTypeModel{}
+	for _, nodeType := range valkeyNodeTypes {
+		if !data.Family.IsNull() && nodeType.Family != data.Family.ValueString() {
+			continue
+		}
+		if !data.MinMemoryGib.IsNull() && nodeType.MemoryGib < data.MinMemoryGib.ValueFloat64() {
+			continue
+		}
+		data.NodeTypes = append(data.NodeTypes, ValkeyNodeTypesNodeTypeModel{
+			Name:         types.StringValue(nodeType.Name),
+			Family:       types.StringValue(nodeType.Family),
+			Vcpus:        types.Int64Value(nodeType.Vcpus),
+			MemoryGib:    types.Float64Value(nodeType.MemoryGib),
+			NetworkClass: types.StringValue(nodeType.NetworkClass),
+		})
+	}
+
+	data.Id = types.StringValue("placeholder")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
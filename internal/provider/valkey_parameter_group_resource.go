@@ -0,0 +1,472 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &ValkeyParameterGroupResource{}
+	_ resource.ResourceWithConfigure      = &ValkeyParameterGroupResource{}
+	_ resource.ResourceWithImportState    = &ValkeyParameterGroupResource{}
+	_ resource.ResourceWithValidateConfig = &ValkeyParameterGroupResource{}
+)
+
+// valkeyEngineVersions are the supported Valkey engine versions in upgrade order.
+var valkeyEngineVersions = []string{"7.2", "8.0", "8.1"}
+
+// valkeyParameterValidator returns an error describing why a value is not valid for a parameter.
+type valkeyParameterValidator func(value string) error
+
+func valkeyParameterOneOf(allowed ...string) valkeyParameterValidator {
+	return func(value string) error {
+		if !slices.Contains(allowed, value) {
+			return fmt.Errorf("must be one of %s", strings.Join(allowed, ", "))
+		}
+		return nil
+	}
+}
+
+func valkeyParameterIntBetween(minValue int64, maxValue int64) valkeyParameterValidator {
+	return func(value string) error {
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || n < minValue || n > maxValue {
+			return fmt.Errorf("must be an integer between %d and %d", minValue, maxValue)
+		}
+		return nil
+	}
+}
+
+var valkeyKeyspaceEventsRegex = regexp.MustCompile(`^[KEg$lshzxeAtmdn]*$`)
+
+func valkeyParameterKeyspaceEvents(value string) error {
+	if !valkeyKeyspaceEventsRegex.MatchString(value) {
+		return fmt.Errorf("must only contain the event classes K, E, g, $, l, s, h, z, x, e, A, t, m, d and n")
+	}
+	return nil
+}
+
+var valkeyYesNo = valkeyParameterOneOf("yes", "no")
+
+// valkeyParameterCatalog lists the parameters that can be set in a parameter group for each engine version.
+var valkeyParameterCatalog = func() map[string]map[string]valkeyParameterValidator {
+	base := map[string]valkeyParameterValidator{
+		"maxmemory-policy":        valkeyParameterOneOf("noeviction", "allkeys-lru", "allkeys-lfu", "allkeys-random", "volatile-lru", "volatile-lfu", "volatile-random", "volatile-ttl"),
+		"maxmemory-samples":       valkeyParameterIntBetween(1, 64),
+		"timeout":                 valkeyParameterIntBetween(0, 2147483647),
+		"tcp-keepalive":           valkeyParameterIntBetween(0, 2147483647),
+		"notify-keyspace-events":  valkeyParameterKeyspaceEvents,
+		"lazyfree-lazy-eviction":  valkeyYesNo,
+		"lazyfree-lazy-expire":    valkeyYesNo,
+		"lazyfree-lazy-user-del":  valkeyYesNo,
+		"activedefrag":            valkeyYesNo,
+		"slowlog-log-slower-than": valkeyParameterIntBetween(-1, 2147483647),
+		"slowlog-max-len":         valkeyParameterIntBetween(0, 2147483647),
+	}
+	catalog := map[string]map[string]valkeyParameterValidator{}
+	for _, version := range valkeyEngineVersions {
+		parameters := make(map[string]valkeyParameterValidator, len(base)+1)
+		for name, validator := range base {
+			parameters[name] = validator
+		}
+		// Added in Valkey 8.0
+		if version != "7.2" {
+			parameters["extended-redis-compatibility"] = valkeyYesNo
+		}
+		catalog[version] = parameters
+	}
+	return catalog
+}()
+
+// validateValkeyEngineVersion checks that an engine version is supported. Unknown values are not checked.
+func validateValkeyEngineVersion(attributePath path.Path, engineVersion types.String) *AttributeError {
+	if engineVersion.IsNull() || engineVersion.IsUnknown() {
+		return nil
+	}
+	if !slices.Contains(valkeyEngineVersions, engineVersion.ValueString()) {
+		return &AttributeError{
+			AttributePath: attributePath,
+			Summary:       "Invalid value",
+			Detail:        fmt.Sprintf("Engine version must be one of %s, got %q.", strings.Join(valkeyEngineVersions, ", "), engineVersion.ValueString()),
+		}
+	}
+	return nil
+}
+
+// valkeyEngineUpgradePath returns the versions to upgrade through, in order, to go from one engine version to a
+// later one. Each supported version in between is visited so that no upgrade skips a version.
+func valkeyEngineUpgradePath(from string, to string) []string {
+	fromIndex := slices.Index(valkeyEngineVersions, from)
+	toIndex := slices.Index(valkeyEngineVersions, to)
+	if fromIndex < 0 || toIndex <= fromIndex {
+		return []string{to}
+	}
+	return valkeyEngineVersions[fromIndex+1 : toIndex+1]
+}
+
+// isValkeyEngineDowngrade reports whether going from one supported engine version to another is a downgrade.
+func isValkeyEngineDowngrade(from string, to string) bool {
+	fromIndex := slices.Index(valkeyEngineVersions, from)
+	toIndex := slices.Index(valkeyEngineVersions, to)
+	return fromIndex >= 0 && toIndex >= 0 && toIndex < fromIndex
+}
+
+func NewValkeyParameterGroupResource() resource.Resource {
+	return &ValkeyParameterGroupResource{}
+}
+
+// ValkeyParameterGroupResource defines the resource implementation.
+type ValkeyParameterGroupResource struct {
+	httpClient    *http.Client
+	httpEndpoint  string
+	httpAuthToken string
+}
+
+// ValkeyParameterGroupResourceModel describes the resource data model.
+type ValkeyParameterGroupResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	EngineVersion types.String `tfsdk:"engine_version"`
+	Description   types.String `tfsdk:"description"`
+	Parameters    types.Map    `tfsdk:"parameters"`
+}
+
+func (r *ValkeyParameterGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_valkey_parameter_group"
+}
+
+func (r *ValkeyParameterGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A group of Valkey server parameters that Valkey Clusters can use through their `parameter_group_name` attribute.",
+
+		Attributes: map[string]schema.Attribute{
+			// The testing framework requires an id attribute to be present in every data source and resource
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the parameter group, which is the name of the parameter group.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the parameter group. Changing the name destroys and recreates the parameter group.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"engine_version": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The Valkey engine version the parameters apply to, one of %s. Only clusters running this version can use the parameter group. Changing the version destroys and recreates the parameter group.", "`"+strings.Join(valkeyEngineVersions, "`, `")+"`"),
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A description of the parameter group.",
+				Optional:            true,
+			},
+			"parameters": schema.MapAttribute{
+				MarkdownDescription: "The parameters to set, such as `maxmemory-policy`, `timeout` and `notify-keyspace-events`. Parameters that are not set keep their Valkey defaults. Parameters changed outside of Terraform are detected and reverted on the next apply.",
+				ElementType:         types.StringType,
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *ValkeyParameterGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(MomentoClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected MomentoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.httpClient = clients.httpClient
+	r.httpEndpoint = clients.httpEndpoint
+	r.httpAuthToken = clients.httpAuthToken
+}
+
+// Checks the engine version and every known parameter against the parameter catalog of that version.
+func (r *ValkeyParameterGroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var engineVersion types.String
+	var parameters types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("engine_version"), &engineVersion)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("parameters"), &parameters)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if attrErr := validateValkeyEngineVersion(path.Root("engine_version"), engineVersion); attrErr != nil {
+		resp.Diagnostics.AddAttributeError(attrErr.AttributePath, attrErr.Summary, attrErr.Detail)
+		return
+	}
+	if engineVersion.IsNull() || engineVersion.IsUnknown() || parameters.IsNull() || parameters.IsUnknown() {
+		return
+	}
+
+	catalog := valkeyParameterCatalog[engineVersion.ValueString()]
+	for name, element := range parameters.Elements() {
+		parameterPath := path.Root("parameters").AtMapKey(name)
+		validator, ok := catalog[name]
+		if !ok {
+			supported := make([]string, 0, len(catalog))
+			for supportedName := range catalog {
+				supported = append(supported, supportedName)
+			}
+			sort.Strings(supported)
+			resp.Diagnostics.AddAttributeError(
+				parameterPath,
+				"Unsupported parameter",
+				fmt.Sprintf("Parameter %q cannot be set for engine version %s. Supported parameters are: %s.", name, engineVersion.ValueString(), strings.Join(supported, ", ")),
+			)
+			continue
+		}
+		value, ok := element.(types.String)
+		if !ok || value.IsUnknown() || value.IsNull() {
+			continue
+		}
+		if err := validator(value.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				parameterPath,
+				"Invalid value",
+				fmt.Sprintf("Parameter %q %s, got %q.", name, err, value.ValueString()),
+			)
+		}
+	}
+}
+
+func (r *ValkeyParameterGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ValkeyParameterGroupResourceModel
+
+	// Retrieve values from the plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var parameters map[string]string
+	resp.Diagnostics.Append(plan.Parameters.ElementsAs(ctx, &parameters, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requestMap := map[string]interface{}{
+		"name":           plan.Name.ValueString(),
+		"engine_version": plan.EngineVersion.ValueString(),
+		"parameters":     parameters,
+	}
+	if !plan.Description.IsNull() {
+		requestMap["description"] = plan.Description.ValueString()
+	}
+	err := r.sendParameterGroupRequest("POST", fmt.Sprintf("%s/ec-parameter-group", r.httpEndpoint), requestMap)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create valkey parameter group, got error: %s", err))
+		return
+	}
+
+	plan.Id = types.StringValue(plan.Name.ValueString())
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ValkeyParameterGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ValkeyParameterGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	foundGroup, err := describeValkeyParameterGroup(*r.httpClient, state.Id.ValueString(), r.httpEndpoint, r.httpAuthToken)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to describe valkey parameter group, got error: %s", err))
+		return
+	}
+	if foundGroup == nil {
+		resp.Diagnostics.AddWarning("Parameter Group Not Found", fmt.Sprintf("Parameter group with name \"%s\" not found, removing from state", state.Id.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Name = types.StringValue(foundGroup.Name)
+	state.EngineVersion = types.StringValue(foundGroup.EngineVersion)
+	if foundGroup.Description != "" || !state.Description.IsNull() {
+		state.Description = types.StringValue(foundGroup.Description)
+	}
+
+	// The parameters set on the group are refreshed so that changes made outside of Terraform show up as drift
+	parameters := foundGroup.Parameters
+	if parameters == nil {
+		parameters = map[string]string{}
+	}
+	parameterMap, diags := types.MapValueFrom(ctx, types.StringType, parameters)
+	resp.Diagnostics.Append(diags...)
+	state.Parameters = parameterMap
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ValkeyParameterGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ValkeyParameterGroupResourceModel
+
+	// Read Terraform planned state into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var parameters map[string]string
+	resp.Diagnostics.Append(plan.Parameters.ElementsAs(ctx, &parameters, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The request replaces the parameters of the group, so parameters removed from the configuration revert to their defaults
+	requestMap := map[string]interface{}{
+		"description": plan.Description.ValueString(),
+		"parameters":  parameters,
+	}
+	err := r.sendParameterGroupRequest("PUT", fmt.Sprintf("%s/ec-parameter-group/%s", r.httpEndpoint, plan.Id.ValueString()), requestMap)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update valkey parameter group, got error: %s", err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ValkeyParameterGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ValkeyParameterGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := *r.httpClient
+	deleteRequest, err := http.NewRequest("DELETE", fmt.Sprintf("%s/ec-parameter-group/%s", r.httpEndpoint, state.Id.ValueString()), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create HTTP request to delete valkey parameter group, got error: %s", err))
+		return
+	}
+	deleteRequest.Header.Set("Authorization", r.httpAuthToken)
+	httpResp, err := client.Do(deleteRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete valkey parameter group, got error: %s", err))
+		return
+	}
+	defer func() { _ = httpResp.Body.Close() }()
+	// A parameter group that is already gone does not need to be deleted
+	if httpResp.StatusCode >= 300 && httpResp.StatusCode != 404 {
+		body, _ := io.ReadAll(httpResp.Body)
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete valkey parameter group, got non-200 response: %s %s. A parameter group cannot be deleted while a cluster uses it.", httpResp.Status, string(body)))
+	}
+}
+
+func (r *ValkeyParameterGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *ValkeyParameterGroupResource) sendParameterGroupRequest(method string, url string, requestMap map[string]interface{}) error {
+	requestJson, err := json.Marshal(requestMap)
+	if err != nil {
+		return err
+	}
+
+	client := *r.httpClient
+	httpRequest, err := http.NewRequest(method, url, bytes.NewBuffer(requestJson))
+	if err != nil {
+		return err
+	}
+	httpRequest.Header.Set("Authorization", r.httpAuthToken)
+	httpRequest.Header.Set("Content-Type", "application/json")
+
+	httpResp, err := client.Do(httpRequest)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = httpResp.Body.Close() }()
+	if httpResp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(httpResp.Body)
+		return fmt.Errorf("got non-200 response: %s %s", httpResp.Status, string(respBody))
+	}
+	return nil
+}
+
+type DescribeValkeyParameterGroupResponseData struct {
+	Name          string            `json:"name"`
+	EngineVersion string            `json:"engine_version"`
+	Description   string            `json:"description"`
+	Parameters    map[string]string `json:"parameters"`
+}
+
+// GET /ec-parameter-group/<name>
+// Returns nil without an error when the parameter group does not exist.
+func describeValkeyParameterGroup(client http.Client, name string, httpEndpoint string, httpAuthToken string) (*DescribeValkeyParameterGroupResponseData, error) {
+	getRequest, err := http.NewRequest("GET", fmt.Sprintf("%s/ec-parameter-group/%s", httpEndpoint, name), nil)
+	if err != nil {
+		return nil, err
+	}
+	getRequest.Header.Set("Authorization", httpAuthToken)
+	getResp, err := client.Do(getRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = getResp.Body.Close() }()
+	// Do not error if 404 not found
+	if getResp.StatusCode == 404 {
+		return nil, nil
+	}
+	if getResp.StatusCode >= 300 {
+		body, _ := io.ReadAll(getResp.Body)
+		return nil, fmt.Errorf("unable to describe valkey parameter group, got non-200 response: %s %s", getResp.Status, string(body))
+	}
+
+	bodyBytes, err := io.ReadAll(getResp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %v", err)
+	}
+
+	var group DescribeValkeyParameterGroupResponseData
+	err = json.Unmarshal(bodyBytes, &group)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling JSON: %v", err)
+	}
+	return &group, nil
+}
@@ -0,0 +1,435 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &ValkeyUserResource{}
+	_ resource.ResourceWithConfigure      = &ValkeyUserResource{}
+	_ resource.ResourceWithImportState    = &ValkeyUserResource{}
+	_ resource.ResourceWithValidateConfig = &ValkeyUserResource{}
+	_ resource.ResourceWithModifyPlan     = &ValkeyUserResource{}
+)
+
+const (
+	valkeyUserMinPasswordLength       = 16
+	valkeyUserMaxPasswordLength       = 128
+	valkeyUserGeneratedPasswordLength = 32
+	valkeyUserPasswordAlphabet        = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+)
+
+var valkeyUsernameRegex = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_-]{0,39}$`)
+
+func NewValkeyUserResource() resource.Resource {
+	return &ValkeyUserResource{}
+}
+
+// ValkeyUserResource defines the resource implementation.
+type ValkeyUserResource struct {
+	httpClient    *http.Client
+	httpEndpoint  string
+	httpAuthToken string
+}
+
+// ValkeyUserResourceModel describes the resource data model.
+type ValkeyUserResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	ClusterName     types.String `tfsdk:"cluster_name"`
+	Username        types.String `tfsdk:"username"`
+	AccessString    types.String `tfsdk:"access_string"`
+	PasswordWo      types.String `tfsdk:"password_wo"`
+	PasswordVersion types.Int64  `tfsdk:"password_version"`
+	Password        types.String `tfsdk:"password"`
+}
+
+func (r *ValkeyUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_valkey_user"
+}
+
+func (r *ValkeyUserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A user of a Valkey Cluster with `auth_mode = \"acl\"`. Each service can authenticate as its own user, limited to the commands and keys allowed by the user's access string.",
+
+		Attributes: map[string]schema.Attribute{
+			// The testing framework requires an id attribute to be present in every data source and resource
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the user, in the format `<cluster_name>/<username>`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the Valkey Cluster the user belongs to. Changing the cluster destroys and recreates the user.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Name of the user. Must start with a letter and contain at most 40 letters, digits, hyphens and underscores. Changing the name destroys and recreates the user.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"access_string": schema.StringAttribute{
+				MarkdownDescription: "The ACL rules of the user, such as `on ~app:* +@read +@write`. See https://valkey.io/topics/acl/ for the syntax. Changing the rules updates the user in place.",
+				Required:            true,
+			},
+			"password_wo": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The password of the user, between %d and %d characters. The password is write-only, so it is not stored in state; change `password_version` to apply a new password. When not set, a password is generated and returned in `password`. Requires Terraform 1.11 or later.", valkeyUserMinPasswordLength, valkeyUserMaxPasswordLength),
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"password_version": schema.Int64Attribute{
+				MarkdownDescription: "Changing the version rotates the password without recreating the user: the current `password_wo` is applied, or a new password is generated when `password_wo` is not set.",
+				Optional:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The generated password of the user, or null when `password_wo` is set.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *ValkeyUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(MomentoClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected MomentoClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.httpClient = clients.httpClient
+	r.httpEndpoint = clients.httpEndpoint
+	r.httpAuthToken = clients.httpAuthToken
+}
+
+func (r *ValkeyUserResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var username, accessString, passwordWo types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("username"), &username)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("access_string"), &accessString)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &passwordWo)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !username.IsNull() && !username.IsUnknown() && !valkeyUsernameRegex.MatchString(username.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("username"),
+			"Invalid value",
+			fmt.Sprintf("username must start with a letter and contain at most 40 letters, digits, hyphens and underscores, got %q.", username.ValueString()),
+		)
+	}
+	if !accessString.IsNull() && !accessString.IsUnknown() && strings.TrimSpace(accessString.ValueString()) == "" {
+		resp.Diagnostics.AddAttributeError(path.Root("access_string"), "Missing required value", "The access string is required.")
+	}
+	if !passwordWo.IsNull() && !passwordWo.IsUnknown() {
+		if length := len(passwordWo.ValueString()); length < valkeyUserMinPasswordLength || length > valkeyUserMaxPasswordLength {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("password_wo"),
+				"Invalid value",
+				fmt.Sprintf("password_wo must be between %d and %d characters long, got %d characters.", valkeyUserMinPasswordLength, valkeyUserMaxPasswordLength, length),
+			)
+		}
+	}
+}
+
+// Plans a new generated password when the password is rotated, and keeps the current one otherwise.
+func (r *ValkeyUserResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Skip Create (state null) and Delete (plan null).
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan ValkeyUserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.PasswordVersion.Equal(state.PasswordVersion) {
+		plan.Password = state.Password
+	} else {
+		plan.Password = types.StringUnknown()
+	}
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *ValkeyUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ValkeyUserResourceModel
+
+	// Retrieve values from the plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	password, diags := r.passwordToApply(ctx, req.Config, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requestMap := map[string]interface{}{
+		"username":      plan.Username.ValueString(),
+		"access_string": plan.AccessString.ValueString(),
+		"passwords":     []string{password},
+	}
+	err := r.sendUserRequest("POST", fmt.Sprintf("%s/ec-cluster/%s/user", r.httpEndpoint, plan.ClusterName.ValueString()), requestMap)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create valkey user, got error: %s", err))
+		return
+	}
+
+	plan.Id = types.StringValue(fmt.Sprintf("%s/%s", plan.ClusterName.ValueString(), plan.Username.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ValkeyUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ValkeyUserResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	foundUser, err := describeValkeyUser(*r.httpClient, state.ClusterName.ValueString(), state.Username.ValueString(), r.httpEndpoint, r.httpAuthToken)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to describe valkey user, got error: %s", err))
+		return
+	}
+	if foundUser == nil {
+		resp.Diagnostics.AddWarning("User Not Found", fmt.Sprintf("User \"%s\" of cluster \"%s\" not found, removing from state", state.Username.ValueString(), state.ClusterName.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.AccessString = types.StringValue(foundUser.AccessString)
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ValkeyUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state, plan ValkeyUserResourceModel
+
+	// Read Terraform prior state and planned state into the models
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requestMap := map[string]interface{}{
+		"access_string": plan.AccessString.ValueString(),
+	}
+	// The password is only sent when it is rotated, which replaces the current password
+	if !plan.PasswordVersion.Equal(state.PasswordVersion) {
+		password, diags := r.passwordToApply(ctx, req.Config, &plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		requestMap["passwords"] = []string{password}
+	}
+	err := r.sendUserRequest("PUT", fmt.Sprintf("%s/ec-cluster/%s/user/%s", r.httpEndpoint, plan.ClusterName.ValueString(), plan.Username.ValueString()), requestMap)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update valkey user, got error: %s", err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ValkeyUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ValkeyUserResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := *r.httpClient
+	deleteRequest, err := http.NewRequest("DELETE", fmt.Sprintf("%s/ec-cluster/%s/user/%s", r.httpEndpoint, state.ClusterName.ValueString(), state.Username.ValueString()), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create HTTP request to delete valkey user, got error: %s", err))
+		return
+	}
+	deleteRequest.Header.Set("Authorization", r.httpAuthToken)
+	httpResp, err := client.Do(deleteRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete valkey user, got error: %s", err))
+		return
+	}
+	defer func() { _ = httpResp.Body.Close() }()
+	// A user that is already gone, for example with its cluster, does not need to be deleted
+	if httpResp.StatusCode >= 300 && httpResp.StatusCode != 404 {
+		body, _ := io.ReadAll(httpResp.Body)
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete valkey user, got non-200 response: %s %s", httpResp.Status, string(body)))
+	}
+}
+
+func (r *ValkeyUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	clusterName, username, err := parseValkeyUserId(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_name"), clusterName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("username"), username)...)
+}
+
+func parseValkeyUserId(id string) (string, string, error) {
+	clusterName, username, ok := strings.Cut(id, "/")
+	if !ok || clusterName == "" || username == "" {
+		return "", "", fmt.Errorf("expected import ID in the form cluster_name/username, got: %q", id)
+	}
+	return clusterName, username, nil
+}
+
+// passwordToApply returns the write-only password from the configuration, or generates a password and records it in
+// the model when none is configured.
+func (r *ValkeyUserResource) passwordToApply(ctx context.Context, config tfsdk.Config, model *ValkeyUserResourceModel) (string, diag.Diagnostics) {
+	var passwordWo types.String
+	diags := config.GetAttribute(ctx, path.Root("password_wo"), &passwordWo)
+	if diags.HasError() {
+		return "", diags
+	}
+	if !passwordWo.IsNull() {
+		model.Password = types.StringNull()
+		return passwordWo.ValueString(), diags
+	}
+
+	password, err := generateValkeyUserPassword()
+	if err != nil {
+		diags.AddError("Password Generation Failed", fmt.Sprintf("Unable to generate a password, got error: %s", err))
+		return "", diags
+	}
+	model.Password = types.StringValue(password)
+	return password, diags
+}
+
+func generateValkeyUserPassword() (string, error) {
+	alphabetSize := big.NewInt(int64(len(valkeyUserPasswordAlphabet)))
+	password := make([]byte, valkeyUserGeneratedPasswordLength)
+	for i := range password {
+		n, err := rand.Int(rand.Reader, alphabetSize)
+		if err != nil {
+			return "", err
+		}
+		password[i] = valkeyUserPasswordAlphabet[n.Int64()]
+	}
+	return string(password), nil
+}
+
+func (r *ValkeyUserResource) sendUserRequest(method string, url string, requestMap map[string]interface{}) error {
+	requestJson, err := json.Marshal(requestMap)
+	if err != nil {
+		return err
+	}
+
+	client := *r.httpClient
+	httpRequest, err := http.NewRequest(method, url, bytes.NewBuffer(requestJson))
+	if err != nil {
+		return err
+	}
+	httpRequest.Header.Set("Authorization", r.httpAuthToken)
+	httpRequest.Header.Set("Content-Type", "application/json")
+
+	httpResp, err := client.Do(httpRequest)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = httpResp.Body.Close() }()
+	if httpResp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(httpResp.Body)
+		return fmt.Errorf("got non-200 response: %s %s", httpResp.Status, string(respBody))
+	}
+	return nil
+}
+
+type DescribeValkeyUserResponseData struct {
+	Username     string `json:"username"`
+	AccessString string `json:"access_string"`
+	Status       string `json:"status"`
+}
+
+// GET /ec-cluster/<cluster-name>/user/<username>
+// Returns nil without an error when the user does not exist.
+func describeValkeyUser(client http.Client, clusterName string, username string, httpEndpoint string, httpAuthToken string) (*DescribeValkeyUserResponseData, error) {
+	getRequest, err := http.NewRequest("GET", fmt.Sprintf("%s/ec-cluster/%s/user/%s", httpEndpoint, clusterName, username), nil)
+	if err != nil {
+		return nil, err
+	}
+	getRequest.Header.Set("Authorization", httpAuthToken)
+	getResp, err := client.Do(getRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = getResp.Body.Close() }()
+	// Do not error if 404 not found
+	if getResp.StatusCode == 404 {
+		return nil, nil
+	}
+	if getResp.StatusCode >= 300 {
+		body, _ := io.ReadAll(getResp.Body)
+		return nil, fmt.Errorf("unable to describe valkey user, got non-200 response: %s %s", getResp.Status, string(body))
+	}
+
+	bodyBytes, err := io.ReadAll(getResp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %v", err)
+	}
+
+	var user DescribeValkeyUserResponseData
+	err = json.Unmarshal(bodyBytes, &user)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling JSON: %v", err)
+	}
+	return &user, nil
+}
@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// defaultPollInterval is the initial interval between polls when none is configured.
+	defaultPollInterval = 30 * time.Second
+	// maxPollInterval caps the backoff, unless a longer poll interval is configured.
+	maxPollInterval = 2 * time.Minute
+	// pollBackoffMultiplier grows the interval between polls while the status does not change.
+	pollBackoffMultiplier = 1.5
+	// maxConsecutivePollErrors is the number of consecutive failed polls after which waiting gives up.
+	maxConsecutivePollErrors = 5
+)
+
+// waiter polls a long running operation until it finishes.
+type waiter struct {
+	// Description names what is waited for in logs and errors, e.g. `valkey cluster "name" to become active`.
+	Description  string
+	PollInterval time.Duration
+}
+
+// waitCheck returns the current status of the operation and whether the operation has finished.
+type waitCheck func(ctx context.Context) (status string, done bool, err error)
+
+// Wait polls check until it reports the operation has finished and returns the last status. The interval between
+// polls backs off while the status does not change and is reset when it does. Waiting fails when ctx is done or
+// after maxConsecutivePollErrors consecutive errors from check.
+func (w waiter) Wait(ctx context.Context, check waitCheck) (string, error) {
+	initialInterval := w.PollInterval
+	if initialInterval <= 0 {
+		initialInterval = defaultPollInterval
+	}
+	maxInterval := max(maxPollInterval, initialInterval)
+
+	start := time.Now()
+	interval := initialInterval
+	status := ""
+	consecutiveErrors := 0
+	var lastErr error
+
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			elapsed := time.Since(start).Round(time.Second)
+			if lastErr != nil {
+				return status, fmt.Errorf("timed out waiting for %s after %s, last status %q, last error: %v", w.Description, elapsed, status, lastErr)
+			}
+			return status, fmt.Errorf("timed out waiting for %s after %s, last status %q", w.Description, elapsed, status)
+		case <-timer.C:
+		}
+
+		newStatus, done, err := check(ctx)
+		if err != nil {
+			consecutiveErrors++
+			lastErr = err
+			tflog.Warn(ctx, "Error while polling", map[string]interface{}{
+				"waiting_for":        w.Description,
+				"consecutive_errors": consecutiveErrors,
+				"error":              err.Error(),
+			})
+			if consecutiveErrors >= maxConsecutivePollErrors {
+				return status, fmt.Errorf("gave up waiting for %s after %d consecutive errors, last error: %w", w.Description, consecutiveErrors, err)
+			}
+		} else {
+			consecutiveErrors = 0
+			lastErr = nil
+			if newStatus != status {
+				tflog.Info(ctx, "Status changed", map[string]interface{}{
+					"waiting_for": w.Description,
+					"from":        status,
+					"to":          newStatus,
+					"elapsed":     time.Since(start).Round(time.Second).String(),
+				})
+			}
+			interval = nextPollInterval(interval, initialInterval, maxInterval, newStatus != status)
+			status = newStatus
+			if done {
+				return status, nil
+			}
+			tflog.Debug(ctx, "Still waiting", map[string]interface{}{
+				"waiting_for":  w.Description,
+				"status":       status,
+				"next_poll_in": interval.String(),
+				"elapsed":      time.Since(start).Round(time.Second).String(),
+			})
+		}
+		timer.Reset(interval)
+	}
+}
+
+// nextPollInterval returns the interval before the next poll, which is reset when the status changed and otherwise
+// backs off up to maxInterval.
+func nextPollInterval(interval time.Duration, initialInterval time.Duration, maxInterval time.Duration, statusChanged bool) time.Duration {
+	if statusChanged {
+		return initialInterval
+	}
+	return min(time.Duration(float64(interval)*pollBackoffMultiplier), maxInterval)
+}
+
+// pollIntervalFromConfig returns the configured poll interval, or the default when none is configured. The value is
+// checked by validatePollInterval, so an invalid value also falls back to the default.
+func pollIntervalFromConfig(pollInterval types.String) time.Duration {
+	if pollInterval.IsNull() || pollInterval.IsUnknown() {
+		return defaultPollInterval
+	}
+	d, err := time.ParseDuration(pollInterval.ValueString())
+	if err != nil {
+		return defaultPollInterval
+	}
+	return d
+}
+
+// validatePollInterval checks that a configured poll interval is a duration of at least a second.
+func validatePollInterval(pollInterval types.String) *AttributeError {
+	if pollInterval.IsNull() || pollInterval.IsUnknown() {
+		return nil
+	}
+	if d, err := time.ParseDuration(pollInterval.ValueString()); err != nil || d < time.Second {
+		return &AttributeError{
+			AttributePath: path.Root("poll_interval"),
+			Summary:       "Invalid value",
+			Detail:        fmt.Sprintf("poll_interval must be a duration of at least 1s, such as \"30s\", got %q.", pollInterval.ValueString()),
+		}
+	}
+	return nil
+}
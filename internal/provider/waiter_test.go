@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testPollInterval = 10 * time.Millisecond
+
+// testCheck returns the results in order, repeating the last one, and records when each poll happened.
+type testCheck struct {
+	results []testCheckResult
+	polls   []time.Time
+}
+
+type testCheckResult struct {
+	status string
+	done   bool
+	err    error
+}
+
+func (c *testCheck) check(ctx context.Context) (string, bool, error) {
+	c.polls = append(c.polls, time.Now())
+	result := c.results[min(len(c.polls), len(c.results))-1]
+	return result.status, result.done, result.err
+}
+
+func TestWaiterWaitReturnsFinalStatus(t *testing.T) {
+	check := &testCheck{results: []testCheckResult{{status: "Creating"}, {status: "Creating"}, {status: "Active", done: true}}}
+	status, err := waiter{Description: "test", PollInterval: testPollInterval}.Wait(context.Background(), check.check)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if status != "Active" {
+		t.Errorf("status = %q, want %q", status, "Active")
+	}
+	if len(check.polls) != 3 {
+		t.Errorf("polled %d times, want 3", len(check.polls))
+	}
+}
+
+func TestWaiterWaitBacksOffWhileStatusIsUnchanged(t *testing.T) {
+	check := &testCheck{results: []testCheckResult{{status: "Creating"}, {status: "Creating"}, {status: "Creating"}, {status: "Creating"}, {status: "Active", done: true}}}
+	start := time.Now()
+	if _, err := (waiter{Description: "test", PollInterval: testPollInterval}).Wait(context.Background(), check.check); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The first poll changes the status, so the interval is reset before it grows by pollBackoffMultiplier
+	wantIntervals := []time.Duration{testPollInterval, testPollInterval, 15 * time.Millisecond, 22500 * time.Microsecond, 33750 * time.Microsecond}
+	previous := start
+	for i, poll := range check.polls {
+		if interval := poll.Sub(previous); interval < wantIntervals[i] {
+			t.Errorf("interval before poll %d = %s, want at least %s", i+1, interval, wantIntervals[i])
+		}
+		previous = poll
+	}
+}
+
+func TestNextPollInterval(t *testing.T) {
+	tests := []struct {
+		name          string
+		interval      time.Duration
+		statusChanged bool
+		want          time.Duration
+	}{
+		{name: "backs off", interval: 40 * time.Second, want: 60 * time.Second},
+		{name: "is capped", interval: 100 * time.Second, want: maxPollInterval},
+		{name: "stays at the cap", interval: maxPollInterval, want: maxPollInterval},
+		{name: "is reset when the status changes", interval: 100 * time.Second, statusChanged: true, want: defaultPollInterval},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := nextPollInterval(tt.interval, defaultPollInterval, maxPollInterval, tt.statusChanged); got != tt.want {
+				t.Errorf("nextPollInterval() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWaiterWaitGivesUpAfterConsecutiveErrors(t *testing.T) {
+	pollErr := errors.New("service unavailable")
+	check := &testCheck{results: []testCheckResult{{status: "Creating"}, {err: pollErr}}}
+	status, err := waiter{Description: "test", PollInterval: testPollInterval}.Wait(context.Background(), check.check)
+	if !errors.Is(err, pollErr) {
+		t.Fatalf("error = %v, want it to wrap %v", err, pollErr)
+	}
+	if !strings.Contains(err.Error(), "consecutive errors") {
+		t.Errorf("error = %q, want it to report the consecutive errors", err)
+	}
+	if status != "Creating" {
+		t.Errorf("status = %q, want the last status %q", status, "Creating")
+	}
+	if len(check.polls) != 1+maxConsecutivePollErrors {
+		t.Errorf("polled %d times, want %d", len(check.polls), 1+maxConsecutivePollErrors)
+	}
+}
+
+func TestWaiterWaitResetsErrorsAfterSuccessfulPoll(t *testing.T) {
+	pollErr := errors.New("service unavailable")
+	var results []testCheckResult
+	for i := 0; i < maxConsecutivePollErrors-1; i++ {
+		results = append(results, testCheckResult{err: pollErr})
+	}
+	results = append(results, testCheckResult{status: "Creating"})
+	for i := 0; i < maxConsecutivePollErrors-1; i++ {
+		results = append(results, testCheckResult{err: pollErr})
+	}
+	results = append(results, testCheckResult{status: "Active", done: true})
+
+	check := &testCheck{results: results}
+	status, err := waiter{Description: "test", PollInterval: time.Millisecond}.Wait(context.Background(), check.check)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if status != "Active" {
+		t.Errorf("status = %q, want %q", status, "Active")
+	}
+}
+
+func TestWaiterWaitTimesOut(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*testPollInterval)
+	defer cancel()
+	check := &testCheck{results: []testCheckResult{{status: "Creating"}}}
+	status, err := waiter{Description: "test cluster", PollInterval: testPollInterval}.Wait(ctx, check.check)
+	if err == nil {
+		t.Fatal("expected a timeout error")
+	}
+	if !strings.Contains(err.Error(), `timed out waiting for test cluster`) || !strings.Contains(err.Error(), `last status "Creating"`) {
+		t.Errorf("error = %q, want it to report the timeout and last status", err)
+	}
+	if strings.Contains(err.Error(), "last error") {
+		t.Errorf("error = %q, want no last error", err)
+	}
+	if status != "Creating" {
+		t.Errorf("status = %q, want %q", status, "Creating")
+	}
+}
+
+func TestWaiterWaitTimesOutWithLastError(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*testPollInterval)
+	defer cancel()
+	// The interval is too long for the errors to reach maxConsecutivePollErrors before the deadline
+	check := &testCheck{results: []testCheckResult{{status: "Creating"}, {err: errors.New("service unavailable")}}}
+	_, err := waiter{Description: "test cluster", PollInterval: 2 * testPollInterval}.Wait(ctx, check.check)
+	if err == nil {
+		t.Fatal("expected a timeout error")
+	}
+	if !strings.Contains(err.Error(), "timed out waiting for test cluster") || !strings.Contains(err.Error(), "last error: service unavailable") {
+		t.Errorf("error = %q, want it to report the timeout and last error", err)
+	}
+}
+
+func TestWaiterWaitTimesOutBeforeFirstPoll(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	check := &testCheck{results: []testCheckResult{{status: "Active", done: true}}}
+	if _, err := (waiter{Description: "test", PollInterval: testPollInterval}).Wait(ctx, check.check); err == nil {
+		t.Fatal("expected a timeout error")
+	}
+	if len(check.polls) != 0 {
+		t.Errorf("polled %d times, want none", len(check.polls))
+	}
+}